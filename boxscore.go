@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BoxscoreLabels are the section names of a box score image
+type BoxscoreLabels struct {
+	Starters   string
+	Bench      string
+	DNP        string
+	DNPDefault string
+	// Subtotals sum the starters or the bench, Totals the team
+	Subtotals  string
+	Totals     string
+	NotStarted string
+	// OnCourt explain the mark of the players on the court
+	OnCourt     string
+	LastUpdated string
+}
+
+var (
+	BoxscoreLabelsZH = BoxscoreLabels{
+		Starters:    "先發",
+		Bench:       "替補",
+		DNP:         "未上場",
+		DNPDefault:  "教練決定",
+		Subtotals:   "小計",
+		Totals:      "合計",
+		NotStarted:  "未開賽",
+		OnCourt:     "* 場上球員",
+		LastUpdated: "最後更新 %s",
+	}
+	BoxscoreLabelsEN = BoxscoreLabels{
+		Starters:    "STARTERS",
+		Bench:       "BENCH",
+		DNP:         "DNP",
+		DNPDefault:  "COACH'S DECISION",
+		Subtotals:   "SUBTOTALS",
+		Totals:      "TOTALS",
+		NotStarted:  "NOT STARTED",
+		OnCourt:     "* ON COURT",
+		LastUpdated: "LAST UPDATED %s",
+	}
+)
+
+// boxscoreLabels return the box score labels of locale
+func boxscoreLabels(locale string) BoxscoreLabels {
+	if locale == LocaleEN {
+		return BoxscoreLabelsEN
+	}
+	return BoxscoreLabelsZH
+}
+
+func hasPlayed(player GamePlayers) bool {
+	return player.StatTotal.Mins > 0 || player.StatTotal.Secs > 0
+}
+
+// splitBoxscore split players into starters, bench players who played and
+// players who did not, each ordered by their box score order
+func splitBoxscore(players []GamePlayers) ([]GamePlayers, []GamePlayers, []GamePlayers) {
+	// copy before sorting, the snapshot is shared by the cache
+	players = append(players[:0:0], players...)
+	sort.SliceStable(players, func(i, j int) bool {
+		return players[i].Boxscore.Order < players[j].Boxscore.Order
+	})
+	starters, bench, dnp := []GamePlayers{}, []GamePlayers{}, []GamePlayers{}
+	for _, player := range players {
+		switch {
+		case player.Boxscore.IsStarter == "true":
+			starters = append(starters, player)
+		case hasPlayed(player):
+			bench = append(bench, player)
+		default:
+			dnp = append(dnp, player)
+		}
+	}
+	return starters, bench, dnp
+}
+
+// sumPlayers return a player whose stats are the totals of players
+func sumPlayers(players []GamePlayers) GamePlayers {
+	total := GamePlayers{}
+	t := &total.StatTotal
+	seconds := 0
+	for _, player := range players {
+		s := player.StatTotal
+		seconds += s.Mins*60 + s.Secs
+		t.Assists += s.Assists
+		t.Blocks += s.Blocks
+		t.DefRebs += s.DefRebs
+		t.Fga += s.Fga
+		t.Fgm += s.Fgm
+		t.Fouls += s.Fouls
+		t.Fta += s.Fta
+		t.Ftm += s.Ftm
+		t.OffRebs += s.OffRebs
+		t.Points += s.Points
+		t.Rebs += s.Rebs
+		t.Steals += s.Steals
+		t.Tpa += s.Tpa
+		t.Tpm += s.Tpm
+		t.Turnovers += s.Turnovers
+	}
+	t.Mins, t.Secs = seconds/60, seconds%60
+	return total
+}
+
+// boxscoreTitle is the title of a box score image, with the period and clock
+// of a game in progress
+func boxscoreTitle(pInfo *GamePlayerInfo, locale string, zone *time.Location) string {
+	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
+	title += fmt.Sprintf("  %s VS %s", pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.AwayTeam.Profile.Name)
+	if box := pInfo.Payload.Boxscore; box.Status == GameStatusLive {
+		title += fmt.Sprintf("  %d - %d %s %s", box.HomeScore, box.AwayScore, box.StatusDesc, box.PeriodClock)
+	}
+	return title
+}
+
+// boxscoreFooter explain the on-court mark and tell when a box score of a
+// game in progress was drawn, in zone
+func boxscoreFooter(pInfo *GamePlayerInfo, labels BoxscoreLabels, zone *time.Location) []*TextToImageOpt {
+	if pInfo.Payload.Boxscore.Status != GameStatusLive {
+		return nil
+	}
+	now := time.Now().In(zone)
+	return []*TextToImageOpt{
+		{
+			SubTitle: labels.OnCourt + "  " + fmt.Sprintf(labels.LastUpdated, now.Format("15:04:05")),
+		},
+	}
+}
+
+// boxscoreToImageOpts build the starters and bench tables of a team, each
+// ending with a subtotals row and the last with the team totals, and a footer
+// listing the DNP players. The players on the court are marked when live is
+// true.
+func boxscoreToImageOpts(subTitle string, header []string, players []GamePlayers, row func(GamePlayers) []string, labels BoxscoreLabels, live bool) []*TextToImageOpt {
+	starters, bench, dnp := splitBoxscore(players)
+	if len(starters)+len(bench) == 0 || !hasPlayed(sumPlayers(players)) {
+		return []*TextToImageOpt{
+			{SubTitle: labels.NotStarted},
+		}
+	}
+
+	totalsRow := func(label string, players []GamePlayers) []string {
+		totals := row(sumPlayers(players))
+		totals[0] = label
+		if len(totals) > 1 {
+			totals[1] = ""
+		}
+		return totals
+	}
+	section := func(name string, sectionPlayers []GamePlayers, last bool) *TextToImageOpt {
+		data := [][]string{header}
+		highlight := [][]bool{nil}
+		for _, player := range sectionPlayers {
+			cells := row(player)
+			onCourt := live && player.Boxscore.OnCourt == "true"
+			if onCourt {
+				cells[0] = "*" + cells[0]
+			}
+			data = append(data, cells)
+			highlight = append(highlight, []bool{onCourt})
+		}
+		// without a bench the starters subtotals are the team totals
+		if len(bench) > 0 {
+			data = append(data, totalsRow(labels.Subtotals, sectionPlayers))
+		}
+		if last {
+			data = append(data, totalsRow(labels.Totals, players))
+		}
+		return &TextToImageOpt{
+			SubTitle:  subTitle + " " + name,
+			TextData:  data,
+			Highlight: highlight,
+		}
+	}
+	opts := []*TextToImageOpt{section(labels.Starters, starters, len(bench) == 0)}
+	if len(bench) > 0 {
+		opts = append(opts, section(labels.Bench, bench, true))
+	}
+	if len(dnp) > 0 {
+		data := [][]string{}
+		for _, player := range dnp {
+			reason := player.Boxscore.DnpReason
+			if reason == "" {
+				reason = labels.DNPDefault
+			}
+			data = append(data, []string{row(player)[0], reason})
+		}
+		opts = append(opts, &TextToImageOpt{
+			SubTitle: labels.DNP,
+			TextData: data,
+		})
+	}
+	return opts
+}
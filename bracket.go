@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+var (
+	BracketTitleStr     = "季後賽對戰圖"
+	ChampionFormat      = "總冠軍 %s"
+	BracketTBDStr       = "-"
+	BracketStyle        = "bracket"
+	bracketLineColor    = color.RGBA{128, 128, 128, 255}
+	bracketWinningColor = color.RGBA{120, 200, 255, 255}
+)
+
+const (
+	bracketFontSize  = 18
+	bracketLineH     = 26
+	bracketColumnW   = 190
+	bracketGapW      = 28
+	bracketMargin    = 20
+	bracketTop       = 90
+	bracketSlotH     = 3*bracketLineH + 30
+	bracketFirstSets = 4
+	bracketColumns   = 7
+	bracketFinalsCol = bracketColumns / 2
+)
+
+type bracketSeries struct {
+	High *BracketTeam
+	Low  *BracketTeam
+	Text string
+}
+
+// bracketRounds return the series of each round of a group, in bracket order
+func bracketRounds(data *BracketInfo, groupName string) [][]bracketSeries {
+	rounds := [][]bracketSeries{}
+	for _, group := range data.Payload.Groups {
+		if group.GroupName != groupName {
+			continue
+		}
+		for _, round := range group.Rounds {
+			series := []bracketSeries{}
+			for _, s := range round.Series {
+				series = append(series, bracketSeries{High: s.HighSeedOrWest, Low: s.LowSeedOrEast, Text: s.SeriesText})
+			}
+			rounds = append(rounds, series)
+		}
+	}
+	return rounds
+}
+
+// bracketChampion return the champion of the bracket, nil before the finals
+// are over
+func bracketChampion(data *BracketInfo) *BracketTeam {
+	if data.Payload.Champion == nil {
+		return nil
+	}
+	body, err := json.Marshal(data.Payload.Champion)
+	if err != nil {
+		return nil
+	}
+	champion := &BracketTeam{}
+	if err := json.Unmarshal(body, champion); err != nil || champion.Profile.ID == "" {
+		return nil
+	}
+	return champion
+}
+
+// bracketDrawer draw the bracket boxes and their connectors
+type bracketDrawer struct {
+	img *image.RGBA
+	d   *font.Drawer
+}
+
+func (b *bracketDrawer) hline(x0 int, x1 int, y int) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	for x := x0; x <= x1; x++ {
+		b.img.Set(x, y, bracketLineColor)
+	}
+}
+
+func (b *bracketDrawer) vline(x int, y0 int, y1 int) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	for y := y0; y <= y1; y++ {
+		b.img.Set(x, y, bracketLineColor)
+	}
+}
+
+func (b *bracketDrawer) text(x int, y int, text string, c color.Color) {
+	b.d.Src = image.NewUniform(c)
+	b.d.Dot = fixed.P(x, y)
+	b.d.DrawString(text)
+}
+
+func (b *bracketDrawer) centerText(y int, text string, c color.Color) {
+	b.d.Src = image.NewUniform(c)
+	b.d.Dot = fixed.Point26_6{
+		X: (fixed.I(b.img.Bounds().Dx()) - b.d.MeasureString(text)) / 2,
+		Y: fixed.I(y),
+	}
+	b.d.DrawString(text)
+}
+
+func bracketTeamText(team *BracketTeam) (string, color.Color) {
+	if team == nil {
+		return BracketTBDStr, color.White
+	}
+	text := fmt.Sprintf("%d %s", team.Standing.ConfRank, team.Profile.Name)
+	switch {
+	case team.IsWinner:
+		return text, highlightColor
+	case team.IsWinning:
+		return text, bracketWinningColor
+	}
+	return text, color.White
+}
+
+// box draw a series centered at y of the column col
+func (b *bracketDrawer) box(col int, y int, series bracketSeries) {
+	x := bracketColumnX(col)
+	top := y - bracketSlotH/2 + 10
+	bottom := y + bracketSlotH/2 - 10
+	b.hline(x, x+bracketColumnW, top)
+	b.hline(x, x+bracketColumnW, bottom)
+	b.vline(x, top, bottom)
+	b.vline(x+bracketColumnW, top, bottom)
+
+	high, highColor := bracketTeamText(series.High)
+	low, lowColor := bracketTeamText(series.Low)
+	b.text(x+8, top+bracketLineH, high, highColor)
+	b.text(x+8, top+2*bracketLineH, low, lowColor)
+	b.text(x+8, top+3*bracketLineH, series.Text, bracketLineColor)
+}
+
+// connect draw the connector from the side of a box at (x0, y0) to the
+// side of the next round box at (x1, y1)
+func (b *bracketDrawer) connect(x0 int, y0 int, x1 int, y1 int) {
+	mid := (x0 + x1) / 2
+	b.hline(x0, mid, y0)
+	b.vline(mid, y0, y1)
+	b.hline(mid, x1, y1)
+}
+
+func bracketColumnX(col int) int {
+	return bracketMargin + col*(bracketColumnW+bracketGapW)
+}
+
+// bracketCenters return the vertical centers of the series of each round,
+// every series centered between the two series feeding it
+func bracketCenters(rounds int) [][]int {
+	centers := [][]int{}
+	first := []int{}
+	for i := 0; i < bracketFirstSets; i++ {
+		first = append(first, bracketTop+i*bracketSlotH+bracketSlotH/2)
+	}
+	centers = append(centers, first)
+	for r := 1; r < rounds; r++ {
+		prev := centers[r-1]
+		round := []int{}
+		for i := 0; i+1 < len(prev); i += 2 {
+			round = append(round, (prev[i]+prev[i+1])/2)
+		}
+		centers = append(centers, round)
+	}
+	return centers
+}
+
+// drawConference draw the rounds of a conference from the outer column
+// toward the finals, mirrored for the east
+func (b *bracketDrawer) drawConference(rounds [][]bracketSeries, centers [][]int, east bool) {
+	column := func(r int) int {
+		if east {
+			return bracketColumns - 1 - r
+		}
+		return r
+	}
+	for r, round := range rounds {
+		if r >= len(centers) {
+			break
+		}
+		for i, series := range round {
+			if i >= len(centers[r]) {
+				break
+			}
+			b.box(column(r), centers[r][i], series)
+			if r == 0 {
+				continue
+			}
+			for _, feeder := range []int{2 * i, 2*i + 1} {
+				if feeder >= len(centers[r-1]) {
+					continue
+				}
+				from, to := bracketColumnX(column(r-1))+bracketColumnW, bracketColumnX(column(r))
+				if east {
+					from, to = bracketColumnX(column(r-1)), bracketColumnX(column(r))+bracketColumnW
+				}
+				b.connect(from, centers[r-1][feeder], to, centers[r][i])
+			}
+		}
+	}
+}
+
+// ParsePlayoffsToBracketImage draw the bracket with the west on the left and
+// the east on the right, converging on the finals in the middle
+func (app *NBABotClient) ParsePlayoffsToBracketImage(c *gin.Context, data *BracketInfo, locale string) {
+	f, err := loadFont()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	width := 2*bracketMargin + bracketColumns*bracketColumnW + (bracketColumns-1)*bracketGapW
+	height := bracketTop + bracketFirstSets*bracketSlotH + bracketMargin
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.Black, image.ZP, draw.Src)
+	b := &bracketDrawer{
+		img: img,
+		d: &font.Drawer{
+			Dst: img,
+			Src: image.White,
+			Face: truetype.NewFace(f, &truetype.Options{
+				Size:    bracketFontSize,
+				DPI:     72,
+				Hinting: font.HintingNone,
+			}),
+		},
+	}
+
+	b.centerText(bracketMargin+bracketLineH, T(locale, BracketTitleStr), color.White)
+	if champion := bracketChampion(data); champion != nil {
+		b.centerText(bracketMargin+2*bracketLineH, Tf(locale, ChampionFormat, champion.Profile.Name), highlightColor)
+	}
+
+	// the conference finals are the last round before the finals
+	centers := bracketCenters(bracketFinalsCol)
+	west := bracketRounds(data, "Western")
+	east := bracketRounds(data, "Eastern")
+	b.drawConference(west, centers, false)
+	b.drawConference(east, centers, true)
+
+	finalsY := centers[len(centers)-1][0]
+	for _, round := range bracketRounds(data, "Finals") {
+		for _, series := range round {
+			b.box(bracketFinalsCol, finalsY, series)
+		}
+	}
+	if len(west) >= bracketFinalsCol {
+		b.hline(bracketColumnX(bracketFinalsCol-1)+bracketColumnW, bracketColumnX(bracketFinalsCol), finalsY)
+	}
+	if len(east) >= bracketFinalsCol {
+		b.hline(bracketColumnX(bracketFinalsCol)+bracketColumnW, bracketColumnX(bracketFinalsCol+1), finalsY)
+	}
+
+	w := bufio.NewWriter(c.Writer)
+	if err := png.Encode(w, img); err != nil {
+		log.Println(err)
+		return
+	}
+	if err := w.Flush(); err != nil {
+		log.Println(err)
+	}
+}
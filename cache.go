@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type CacheConfig struct {
+	LiveTTL      time.Duration `yaml:"live_ttl"`
+	ScheduledTTL time.Duration `yaml:"scheduled_ttl"`
+	FinalTTL     time.Duration `yaml:"final_ttl"`
+	StandingTTL  time.Duration `yaml:"standing_ttl"`
+}
+
+var defaultCacheConfig = CacheConfig{
+	LiveTTL:      15 * time.Second,
+	ScheduledTTL: 5 * time.Minute,
+	FinalTTL:     12 * time.Hour,
+	StandingTTL:  3 * time.Hour,
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// flightCall is an in-flight fetch shared by concurrent callers of one key
+type flightCall struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// ResponseCache keep upstream responses in memory until their TTL expires.
+// Concurrent misses on the same key share a single fetch.
+type ResponseCache struct {
+	sync.Mutex
+	entries map[string]*cacheEntry
+	flights map[string]*flightCall
+	hits    int64
+	misses  int64
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		entries: map[string]*cacheEntry{},
+		flights: map[string]*flightCall{},
+	}
+}
+
+// Get return the cached value of key, or call fetch and cache its result for
+// the returned ttl. Errors are never cached.
+func (c *ResponseCache) Get(key string, fetch func() (interface{}, time.Duration, error)) (interface{}, error) {
+	c.Lock()
+	now := time.Now()
+	if entry, ok := c.entries[key]; ok && now.Before(entry.expiresAt) {
+		c.Unlock()
+		atomic.AddInt64(&c.hits, 1)
+		return entry.value, nil
+	}
+	if call, ok := c.flights[key]; ok {
+		c.Unlock()
+		atomic.AddInt64(&c.hits, 1)
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	call := &flightCall{}
+	call.wg.Add(1)
+	c.flights[key] = call
+	c.Unlock()
+	atomic.AddInt64(&c.misses, 1)
+
+	var ttl time.Duration
+	func() {
+		// a panicking fetch must still release the callers waiting on it
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("cache fetch %s panic: %v\n%s", key, r, debug.Stack())
+				call.value, call.err = nil, fmt.Errorf("cache fetch %s panic: %v", key, r)
+			}
+			call.wg.Done()
+		}()
+		call.value, ttl, call.err = fetch()
+	}()
+
+	c.Lock()
+	delete(c.flights, key)
+	if call.err == nil && ttl > 0 {
+		c.evictExpired(now)
+		c.entries[key] = &cacheEntry{value: call.value, expiresAt: time.Now().Add(ttl)}
+	}
+	c.Unlock()
+	return call.value, call.err
+}
+
+func (c *ResponseCache) evictExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+type CacheStats struct {
+	Hits    int64
+	Misses  int64
+	Entries int
+}
+
+func (c *ResponseCache) Stats() CacheStats {
+	c.Lock()
+	entries := len(c.entries)
+	c.Unlock()
+	return CacheStats{
+		Hits:    atomic.LoadInt64(&c.hits),
+		Misses:  atomic.LoadInt64(&c.misses),
+		Entries: entries,
+	}
+}
+
+// CachedDataSource wrap a DataSource with a ResponseCache. Returned values are
+// shared between callers and must be treated as read-only.
+type CachedDataSource struct {
+	source DataSource
+	cache  *ResponseCache
+	config CacheConfig
+}
+
+func NewCachedDataSource(source DataSource, config CacheConfig) *CachedDataSource {
+	if config.LiveTTL == 0 {
+		config.LiveTTL = defaultCacheConfig.LiveTTL
+	}
+	if config.ScheduledTTL == 0 {
+		config.ScheduledTTL = defaultCacheConfig.ScheduledTTL
+	}
+	if config.FinalTTL == 0 {
+		config.FinalTTL = defaultCacheConfig.FinalTTL
+	}
+	if config.StandingTTL == 0 {
+		config.StandingTTL = defaultCacheConfig.StandingTTL
+	}
+	return &CachedDataSource{
+		source: source,
+		cache:  NewResponseCache(),
+		config: config,
+	}
+}
+
+func (s *CachedDataSource) CacheStats() CacheStats {
+	return s.cache.Stats()
+}
+
+func (s *CachedDataSource) GetNBAGameToday(locale string) (*GameInfo, error) {
+	// keyed by the local date so yesterday's finished games expire at midnight
+	today, _ := GetLocalTime(time.Now())
+	key := localeKey(scoresTodayKey+"/"+today.Format(NBA_API_TIME_FORMAT), locale)
+	return s.getNBAGame(key, func() (*GameInfo, error) {
+		return s.source.GetNBAGameToday(locale)
+	})
+}
+
+func (s *CachedDataSource) GetNBAGameByDate(date *time.Time, locale string) (*GameInfo, error) {
+	key := localeKey(scoresKey(date), locale)
+	return s.getNBAGame(key, func() (*GameInfo, error) {
+		return s.source.GetNBAGameByDate(date, locale)
+	})
+}
+
+func (s *CachedDataSource) getNBAGame(key string, fetch func() (*GameInfo, error)) (*GameInfo, error) {
+	value, err := s.cache.Get(key, func() (interface{}, time.Duration, error) {
+		data, err := fetch()
+		if err != nil {
+			return nil, 0, err
+		}
+		statuses := []string{}
+		for _, game := range data.Payload.Date.Games {
+			statuses = append(statuses, game.Boxscore.Status)
+		}
+		return data, s.gameTTL(statuses...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*GameInfo), nil
+}
+
+func (s *CachedDataSource) GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error) {
+	key := snapshotKey(id, locale)
+	value, err := s.cache.Get(key, func() (interface{}, time.Duration, error) {
+		data, err := s.source.GetNBAGamePlayerByGameID(id, locale)
+		if err != nil {
+			return nil, 0, err
+		}
+		return data, s.gameTTL(data.Payload.Boxscore.Status), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*GamePlayerInfo), nil
+}
+
+func (s *CachedDataSource) GetNBAConferenceStanding(locale string) (*ConferenceStanding, error) {
+	value, err := s.cache.Get(localeKey(standingKey, locale), func() (interface{}, time.Duration, error) {
+		data, err := s.source.GetNBAConferenceStanding(locale)
+		return data, s.config.StandingTTL, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*ConferenceStanding), nil
+}
+
+func (s *CachedDataSource) GetNBAPlayoffs(locale string) (*BracketInfo, error) {
+	value, err := s.cache.Get(localeKey(bracketKey, locale), func() (interface{}, time.Duration, error) {
+		data, err := s.source.GetNBAPlayoffs(locale)
+		return data, s.config.StandingTTL, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*BracketInfo), nil
+}
+
+// gameTTL pick the TTL for a response holding games in the given statuses:
+// any live game keeps it short, only finished games keep it long.
+func (s *CachedDataSource) gameTTL(statuses ...string) time.Duration {
+	ttl := s.config.FinalTTL
+	for _, status := range statuses {
+		switch status {
+		case GameStatusLive:
+			return s.config.LiveTTL
+		case GameStatusFinal:
+		default:
+			ttl = s.config.ScheduledTTL
+		}
+	}
+	if len(statuses) == 0 {
+		return s.config.ScheduledTTL
+	}
+	return ttl
+}
@@ -0,0 +1,229 @@
+package main
+
+// catalogEN is the English catalog. LINE limits button labels to 20
+// characters, keep the translations of labels as short.
+var catalogEN = Catalog{
+	// dates
+	DATE_TIME_LAYOUT: "Jan 02 15:04",
+
+	// errors
+	"資料來源暫時無法使用，請稍後再試": "The data source is unavailable, please try again later",
+	"查無資料": "No data found",
+	"資料來源回傳錯誤，請稍後再試":         "The data source returned an error, please try again later",
+	"這個按鈕已失效，請輸入 NBA 重新開啟選單": "This button has expired, send NBA to open the menu again",
+	"指令格式錯誤：%s":              "Invalid command: %s",
+	"指令格式錯誤，用法：%s : %s":      "Invalid command, usage: %s : %s",
+
+	// help and menus
+	"支援命令:":         "Commands:",
+	"功能列表":          "Menu",
+	"NBA功能列表":       "NBA Menu",
+	"戰績":            "Standings",
+	"NBA比分":         "NBA Scores",
+	"賽事即時比分":        "Live scores",
+	"NBA戰績":         "NBA Standings",
+	"分區戰績":          "Conference standings",
+	"其它功能":          "More",
+	"說明及追蹤":         "Help and following",
+	"今日賽事":          "Today's Games",
+	"明日賽事":          "Tomorrow's Games",
+	"昨日賽事":          "Yesterday's Games",
+	"東區戰績":          "East Standings",
+	"西區戰績":          "West Standings",
+	"東區詳細戰績":        "East Full Standings",
+	"西區詳細戰績":        "West Full Standings",
+	"分組戰績":          "Division Standings",
+	"季後賽戰績":         "Playoffs",
+	"季後賽形勢":         "Playoff Picture",
+	"數據統計說明":        "Stats Legend",
+	"追蹤清單":          "Following",
+	"語言":            "Language",
+	"今日賽事比分":        "Today's scores",
+	"明日賽程":          "Tomorrow's schedule",
+	"昨日賽事比分":        "Yesterday's scores",
+	"東區戰績圖":         "Eastern Conference standings",
+	"西區戰績圖":         "Western Conference standings",
+	"東區主客場、近10場及分差": "Eastern home, road, last 10 and point differential",
+	"西區主客場、近10場及分差": "Western home, road, last 10 and point differential",
+	"分組戰績，例如：分組戰績 太平洋組":  "Division standings, e.g. 分組戰績 Pacific",
+	"季後賽席位及附加賽形勢":        "Playoff seeds and play-in picture",
+	"數據統計欄位說明":           "Box score columns",
+	"列出追蹤中的球隊":           "List the teams followed",
+	"切換語言，例如：語言 English": "Switch language, e.g. 語言 中文",
+	"今日球員數據，例如：球員 Curry": "Player stats of today, e.g. 球員 Curry",
+	"追蹤球隊，例如：追蹤 湖人":      "Follow a team, e.g. 追蹤 Lakers",
+	"取消追蹤球隊":             "Unfollow a team",
+
+	// games
+	"當日無賽事":                     "No games on this day",
+	"賽事選單":                      "Games Menu",
+	"     主隊 : 客隊\n":            "     Home : Away\n",
+	"%s 數據統計":                   "%s Stats",
+	"比賽選單":                      "Game Menu",
+	"未開賽 | %s ":                 "Scheduled | %s ",
+	"未開賽":                       "Not started",
+	"更新比分 - 未開賽":                "Refresh - Scheduled",
+	"更新比分 - 進行中":                "Refresh - Live",
+	"觀看 Highlights":             "Highlights",
+	"%s vs %s 尚無 Highlights":    "%s vs %s has no highlights yet",
+	"%s vs %s Highlights:\n %s": "%s vs %s Highlights:\n %s",
+	"更新數據統計":                    "Refresh Box Score",
+	"各節比分":                      "Line Score",
+	"團隊數據比較":                    "Team Stats",
+	"比賽領袖":                      "Game Leaders",
+	"賽前預覽 - 本季平均":               "Preview - Season Averages",
+	"得分王 %s / %s":               "Top scorers %s / %s",
+	"場均得分 %s / %s":              "PPG %s / %s",
+	"球隊":                        "TEAM",
+
+	// box scores
+	"先發":          "Starters",
+	"替補":          "Bench",
+	"未上場":         "DNP",
+	"教練決定":        "Coach's decision",
+	"合計":          "Totals",
+	"* 場上球員":      "* On court",
+	"最後更新 %s":     "Last updated %s",
+	"進階數據 ?cols=": "Advanced ?cols=",
+
+	// stats
+	"上場時間":          "Minutes",
+	"得分":            "Points",
+	"籃板":            "Rebounds",
+	"助攻":            "Assists",
+	"抄截":            "Steals",
+	"阻攻":            "Blocks",
+	"失誤":            "Turnovers",
+	"犯規":            "Fouls",
+	"進攻籃板":          "Offensive rebounds",
+	"防守籃板":          "Defensive rebounds",
+	"投籃":            "Field goals",
+	"三分":            "3 pointers",
+	"罰球":            "Free throws",
+	"快攻得分":          "Fast break points",
+	"禁區得分":          "Points in the paint",
+	"失誤得分":          "Points off turnovers",
+	"最大領先":          "Biggest lead",
+	"剩餘暫停":          "Timeouts left",
+	"投籃命中-投籃出手":     "Field goals made-attempted",
+	"三分球命中數-三分球出手數": "3 pointers made-attempted",
+	"罰球命中-罰球次數":     "Free throws made-attempted",
+	"真實命中率":         "True shooting percentage",
+	"有效命中率":         "Effective FG percentage",
+	"比賽評分":          "Game score",
+	"助攻失誤比":         "Assist to turnover ratio",
+	"每36分鐘得分":       "Points per 36 minutes",
+
+	// players
+	"請輸入球員名字或背號，例如：球員 Curry":              "Send a player name or jersey number, e.g. 球員 Curry",
+	"今日賽事找不到球員：%s":                        "No player of today's games matches %s",
+	"%s 的比賽尚未開始，開賽時間 %s":                  "%s has not started yet, tip-off at %s",
+	"還有 %d 位球員符合，請輸入更完整的名字":               "%d more players match, please send a longer name",
+	"上場時間 %02d:%02d":                      "MIN %02d:%02d",
+	"得分 %d | 籃板 %d (進攻 %d 防守 %d) | 助攻 %d": "PTS %d | REB %d (OFF %d DEF %d) | AST %d",
+	"投籃 %s | 三分 %s | 罰球 %s":               "FG %s | 3P %s | FT %s",
+	"抄截 %d | 阻攻 %d | 失誤 %d | 犯規 %d":       "STL %d | BLK %d | TO %d | PF %d",
+
+	// standings
+	"勝負":        "W-L",
+	"勝率":        "PCT",
+	"勝差":        "GB",
+	"主場":        "HOME",
+	"客場":        "ROAD",
+	"同區":        "CONF",
+	"同組":        "DIV",
+	"近10場":      "L10",
+	"連勝敗":       "STRK",
+	"失分":        "PA",
+	"分差":        "DIFF",
+	"%s戰績":      "%s Standings",
+	"請選擇分組":     "Choose a division",
+	"找不到分組「%s」": "No division named %s",
+	"大西洋組":      "Atlantic",
+	"中央組":       "Central",
+	"東南組":       "Southeast",
+	"西北組":       "Northwest",
+	"太平洋組":      "Pacific",
+	"西南組":       "Southwest",
+	"z 聯盟第一 y 分組第一 x 季後賽 o 淘汰": "z league best  y division  x playoffs  o eliminated",
+
+	// playoffs
+	"季後賽對戰表": "Playoff Bracket",
+	"季後賽對戰圖": "Playoff Bracket",
+	"總冠軍 %s": "Champion %s",
+	"東區":     "East",
+	"西區":     "West",
+	"季後賽席位":  "Playoff Seeds",
+	"附加賽":    "Play-In",
+	"爭取附加賽":  "Chasing the Play-In",
+	"已淘汰":    "Eliminated",
+	"分界勝差":   "+/- LINE",
+
+	// following
+	"請輸入球隊名稱，例如：追蹤 湖人":      "Send a team name, e.g. 追蹤 Lakers",
+	"找不到球隊：%s":              "No team named %s",
+	"已追蹤 %s，開賽、中場及比賽結束時會通知": "Following %s, you will be notified at tip-off, halftime and the final",
+	"已取消追蹤 %s":              "Unfollowed %s",
+	"沒有追蹤 %s":               "Not following %s",
+	"尚未追蹤任何球隊":              "Not following any team yet",
+	"追蹤中的球隊：%s":             "Following: %s",
+	"、":                     ", ",
+	"%s vs %s 開賽了！":         "%s vs %s tipped off!",
+	"%s vs %s 中場休息":         "%s vs %s halftime",
+	"%s vs %s 比賽結束":         "%s vs %s final",
+	"%s 近期沒有賽事":             "%s has no upcoming games",
+
+	// schedule
+	"指定日期賽程，例如：賽程 3/15、賽程 週末": "Games of a date, e.g. 賽程 3/15, 賽程 weekend",
+	"賽程":   "Schedule",
+	"選擇日期": "Pick a Date",
+	"請輸入日期，例如：賽程 3/15、賽程 下週一、賽程 週末、賽程 未來7天": "Send a date, e.g. 賽程 3/15, 賽程 next monday, 賽程 weekend, 賽程 next 7 days",
+	"看不懂的日期：%s":   "Unknown date: %s",
+	"一次最多查詢 %d 天": "Up to %d days at a time",
+	"%s - %s 賽程":  "Schedule %s - %s",
+	"無賽事":         "No games",
+	"週末":          "Weekend",
+	"下週":          "Next week",
+	"未來7天":        "Next 7 days",
+	"週日":          "Sun",
+	"週一":          "Mon",
+	"週二":          "Tue",
+	"週三":          "Wed",
+	"週四":          "Thu",
+	"週五":          "Fri",
+	"週六":          "Sat",
+
+	// days without games
+	"下一個比賽日：%s":        "Next game day: %s",
+	"查看 %s 賽事":         "Games on %s",
+	"明星賽假期，賽事將於 %s 恢復": "All-Star break, games resume on %s",
+	"休賽季，%s將於 %s 開打":   "Off-season, the %s starts on %s",
+	"季前賽":              "preseason",
+	"例行賽":              "regular season",
+	"新賽季":              "new season",
+
+	// settings
+	"%s：%s":      "%s: %s",
+	"查看及變更聊天室設定": "Show and change the chat settings",
+	"設定":         "Settings",
+	"目前設定":       "Settings",
+	"開":          "on",
+	"關":          "off",
+	"未設定":        "not set",
+	"時區":         "Timezone",
+	"最愛球隊":       "Favorite Team",
+	"開賽通知":       "Tip-off Alerts",
+	"中場通知":       "Halftime Alerts",
+	"終場通知":       "Final Alerts",
+	"純文字模式":      "Text Only",
+	"標記才回應":      "Mention Only",
+	"請輸入球隊名稱，例如：設定 最愛球隊 湖人，輸入「設定 最愛球隊 無」取消": "Send a team name, e.g. 設定 最愛球隊 Lakers, or 設定 最愛球隊 無 to clear it",
+	"請輸入時區，例如：設定 時區 America/New_York":       "Send a timezone, e.g. 設定 時區 America/New_York",
+	"不支援的時區：%s": "Unsupported timezone: %s",
+	"不支援的設定：%s": "Unsupported setting: %s",
+
+	// language
+	"請選擇語言":     "Choose a language",
+	"已切換為中文":    "Switched to English",
+	"不支援的語言：%s": "Unsupported language: %s",
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// ToDo: 暫無 ＢＡ
+type StaticsColumn struct {
+	// Key select an optional column with "?cols="
+	Key   string
+	CName string
+	EName string
+	Value func(player GamePlayers) string
+	// Optional columns are only drawn when requested
+	Optional bool
+}
+
+// Header is the name of the column in a table of locale. The catalog
+// translates CName to the long description of the legend, English tables use
+// the short EName.
+func (col StaticsColumn) Header(locale string) string {
+	if locale == LocaleEN {
+		return col.EName
+	}
+	return col.CName
+}
+
+func statColumn(value func(player GamePlayers) int) func(player GamePlayers) string {
+	return func(player GamePlayers) string {
+		return strconv.Itoa(value(player))
+	}
+}
+
+var PlayerInfoDetailMapColumn = []StaticsColumn{
+	{CName: "a5", EName: "PLAYERS", Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%s. %s", player.Profile.FirstInitial, player.Profile.LastName)
+	}},
+	{CName: "a6", EName: "POS", Value: func(player GamePlayers) string { return player.Profile.Position }},
+	{CName: "上場時間", EName: "MIN", Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%02d:%02d", player.StatTotal.Mins, player.StatTotal.Secs)
+	}},
+	{CName: "投籃命中-投籃出手", EName: "FGM-A", Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%d-%d", player.StatTotal.Fgm, player.StatTotal.Fga)
+	}},
+	{CName: "三分球命中數-三分球出手數", EName: "3PM-A", Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%d-%d", player.StatTotal.Tpm, player.StatTotal.Tpa)
+	}},
+	{CName: "罰球命中-罰球次數", EName: "FTM-A", Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%d-%d", player.StatTotal.Ftm, player.StatTotal.Fta)
+	}},
+	{CName: "+/-", EName: "+/-", Value: func(player GamePlayers) string { return player.Boxscore.PlusMinus }},
+	{CName: "進攻籃板", EName: "OR", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.OffRebs })},
+	{CName: "防守籃板", EName: "DR", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.DefRebs })},
+	{CName: "籃板", EName: "TR", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.OffRebs + p.StatTotal.DefRebs })},
+	{CName: "助攻", EName: "AS", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Assists })},
+	{CName: "犯規", EName: "PF", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Fouls })},
+	{CName: "抄截", EName: "ST", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Steals })},
+	{CName: "失誤", EName: "TO", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Turnovers })},
+	{CName: "阻攻", EName: "BS", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Blocks })},
+	{CName: "得分", EName: "PTS", Value: statColumn(func(p GamePlayers) int { return p.StatTotal.Points })},
+	{CName: "EFF", EName: "EFF", Value: func(player GamePlayers) string { return strconv.Itoa(playerEff(player)) }},
+	{Key: "TS", CName: "真實命中率", EName: "TS%", Optional: true, Value: func(player GamePlayers) string {
+		s := player.StatTotal
+		return percentage(float64(s.Points), 2*(float64(s.Fga)+0.44*float64(s.Fta)))
+	}},
+	{Key: "EFG", CName: "有效命中率", EName: "EFG%", Optional: true, Value: func(player GamePlayers) string {
+		s := player.StatTotal
+		return percentage(float64(s.Fgm)+0.5*float64(s.Tpm), float64(s.Fga))
+	}},
+	{Key: "GMSC", CName: "比賽評分", EName: "GMSC", Optional: true, Value: func(player GamePlayers) string {
+		return fmt.Sprintf("%.1f", playerGameScore(player))
+	}},
+	{Key: "ASTTO", CName: "助攻失誤比", EName: "AST/TO", Optional: true, Value: func(player GamePlayers) string {
+		s := player.StatTotal
+		if s.Turnovers == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.1f", float64(s.Assists)/float64(s.Turnovers))
+	}},
+	{Key: "PTS36", CName: "每36分鐘得分", EName: "PTS/36", Optional: true, Value: func(player GamePlayers) string {
+		s := player.StatTotal
+		seconds := s.Mins*60 + s.Secs
+		if seconds == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.1f", float64(s.Points)*36*60/float64(seconds))
+	}},
+}
+
+func percentage(value float64, total float64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", value/total*100)
+}
+
+// playerEff return the efficiency of a player:
+// (PTS + TRB + AST + STL + BLK) - (FGA-FGM) - (FTA-FTM) - TO
+func playerEff(player GamePlayers) int {
+	s := player.StatTotal
+	return (s.Points + s.OffRebs + s.DefRebs + s.Assists + s.Steals + s.Blocks) - (s.Fga - s.Fgm) - (s.Fta - s.Ftm) - s.Turnovers
+}
+
+// playerGameScore return the Hollinger game score of a player:
+// PTS + 0.4FGM - 0.7FGA - 0.4(FTA-FTM) + 0.7ORB + 0.3DRB + STL + 0.7AST + 0.7BLK - 0.4PF - TO
+func playerGameScore(player GamePlayers) float64 {
+	s := player.StatTotal
+	return float64(s.Points) + 0.4*float64(s.Fgm) - 0.7*float64(s.Fga) - 0.4*float64(s.Fta-s.Ftm) +
+		0.7*float64(s.OffRebs) + 0.3*float64(s.DefRebs) + float64(s.Steals) + 0.7*float64(s.Assists) +
+		0.7*float64(s.Blocks) - 0.4*float64(s.Fouls) - float64(s.Turnovers)
+}
+
+// optionalColumns return the optional columns of keys, a comma separated
+// list like "TS,EFG,GMSC", in the order requested
+func optionalColumns(keys string) ([]StaticsColumn, error) {
+	cols := []StaticsColumn{}
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		found := false
+		for _, col := range PlayerInfoDetailMapColumn {
+			if col.Optional && col.Key == key {
+				cols = append(cols, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			log.Printf("optionalColumns unknown column %q", key)
+			return nil, fmt.Errorf("unknown column %q", key)
+		}
+	}
+	return cols, nil
+}
@@ -0,0 +1,554 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	CommandBadArgsStr = "指令格式錯誤：%s"
+	CommandUsageStr   = "指令格式錯誤，用法：%s : %s"
+)
+
+// CommandArgs are the arguments parsed from the text following a command name
+type CommandArgs struct {
+	Page  int
+	Query string
+}
+
+// CommandContext is one text message dispatched to a command
+type CommandContext struct {
+	Command *Command
+	Text    string
+	Args    CommandArgs
+	Message *linebot.TextMessage
+	Source  *linebot.EventSource
+	// Settings of the chat
+	Settings ChatSettings
+	// Locale of the chat, replies are translated to it
+	Locale string
+}
+
+// CommandHandler build the reply of a command. A returned error is logged and
+// answered with sourceErrorText.
+type CommandHandler func(ctx *CommandContext) (linebot.SendingMessage, error)
+
+// CommandMiddleware wrap every CommandHandler of a registry
+type CommandMiddleware func(next CommandHandler) CommandHandler
+
+type Command struct {
+	Name    string
+	Aliases []string
+	// Label is the button text of the command in the help carousel
+	Label       string
+	Description string
+	// Group is the help carousel column of the command, hidden if empty
+	Group string
+	// Prefix commands match any text starting with their name
+	Prefix bool
+	// Action build the button of the command in the help carousel, a
+	// postback running the command by default
+	Action  func(locale string) linebot.TemplateAction
+	Parse   func(rest string) (CommandArgs, error)
+	Handler CommandHandler
+}
+
+// CommandGroup is a column of the help carousel
+type CommandGroup struct {
+	Name     string
+	Title    string
+	Text     string
+	ImageURL string
+}
+
+type CommandRegistry struct {
+	commands    []*Command
+	groups      []CommandGroup
+	names       map[string]*Command
+	prefixes    []string
+	middlewares []CommandMiddleware
+	// Fallback handle the texts no command matched
+	Fallback *Command
+}
+
+func NewCommandRegistry() *CommandRegistry {
+	return &CommandRegistry{
+		names: map[string]*Command{},
+	}
+}
+
+func (r *CommandRegistry) Register(cmd *Command) {
+	if cmd.Parse == nil {
+		cmd.Parse = parseNoArgs
+	}
+	r.commands = append(r.commands, cmd)
+	for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+		name = normalizeCommandText(name)
+		if _, ok := r.names[name]; ok {
+			log.Printf("command %s registered twice", name)
+		}
+		r.names[name] = cmd
+		if cmd.Prefix {
+			r.prefixes = append(r.prefixes, name)
+		}
+	}
+	// longest first, so 取消追蹤 wins over 追蹤
+	sort.Slice(r.prefixes, func(i, j int) bool {
+		return len(r.prefixes[i]) > len(r.prefixes[j])
+	})
+}
+
+func (r *CommandRegistry) RegisterGroup(group CommandGroup) {
+	r.groups = append(r.groups, group)
+}
+
+// Use add a middleware, the first added is the outermost
+func (r *CommandRegistry) Use(middleware CommandMiddleware) {
+	r.middlewares = append(r.middlewares, middleware)
+}
+
+// Commands return the registered commands in registration order
+func (r *CommandRegistry) Commands() []*Command {
+	return r.commands
+}
+
+// Match find the command of text and the text following its name
+func (r *CommandRegistry) Match(text string) (*Command, string) {
+	text = normalizeCommandText(text)
+	// "<name>@<page>"
+	head := strings.SplitN(text, "@", 2)[0]
+	if cmd, ok := r.names[head]; ok && !cmd.Prefix {
+		return cmd, text[len(head):]
+	}
+	for _, name := range r.prefixes {
+		if strings.HasPrefix(text, name) {
+			return r.names[name], text[len(name):]
+		}
+	}
+	return nil, text
+}
+
+// Dispatch run the command matched by the message through the middlewares,
+// with the settings of the chat
+func (r *CommandRegistry) Dispatch(message *linebot.TextMessage, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	cmd, rest := r.Match(message.Text)
+	if cmd == nil {
+		if cmd = r.Fallback; cmd == nil {
+			return nil, nil
+		}
+	}
+	ctx := &CommandContext{
+		Command:  cmd,
+		Text:     normalizeCommandText(message.Text),
+		Message:  message,
+		Source:   source,
+		Settings: settings,
+		Locale:   settings.ReplyLocale(),
+	}
+	handler := func(ctx *CommandContext) (linebot.SendingMessage, error) {
+		args, err := ctx.Command.Parse(rest)
+		if err != nil {
+			return nil, &CommandParseError{Command: ctx.Command, Text: rest, Err: err}
+		}
+		ctx.Args = args
+		return ctx.Command.Handler(ctx)
+	}
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		handler = r.middlewares[i](handler)
+	}
+	return handler(ctx)
+}
+
+// CommandParseError is returned by Dispatch when the text following a command
+// name is not valid arguments of the command
+type CommandParseError struct {
+	Command *Command
+	Text    string
+	Err     error
+}
+
+func (e *CommandParseError) Error() string {
+	return fmt.Sprintf("%s: parse %q: %v", e.Command.Name, e.Text, e.Err)
+}
+
+func (e *CommandParseError) Unwrap() error {
+	return e.Err
+}
+
+// commandErrorText is the reply to a failed command: the usage of the
+// command for bad arguments, otherwise sourceErrorText
+func commandErrorText(err error, locale string) string {
+	var parseErr *CommandParseError
+	if !errors.As(err, &parseErr) {
+		return sourceErrorText(err, locale)
+	}
+	if parseErr.Command.Description == "" {
+		return Tf(locale, CommandBadArgsStr, parseErr.Command.Name)
+	}
+	return Tf(locale, CommandUsageStr, parseErr.Command.Name, T(locale, parseErr.Command.Description))
+}
+
+// HelpText list the commands having a description
+func (r *CommandRegistry) HelpText(locale string) string {
+	lines := []string{T(locale, "支援命令:")}
+	for _, cmd := range r.commands {
+		if cmd.Description == "" {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("   %s : %s", cmd.Name, T(locale, cmd.Description)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// HelpCarousel build one column of buttons per command group
+func (r *CommandRegistry) HelpCarousel(locale string) linebot.SendingMessage {
+	const actionsPerColumn = 3
+	columns := []*linebot.CarouselColumn{}
+	for _, group := range r.groups {
+		actions := []linebot.TemplateAction{}
+		for _, cmd := range r.commands {
+			if cmd.Group != group.Name {
+				continue
+			}
+			if cmd.Action != nil {
+				actions = append(actions, cmd.Action(locale))
+				continue
+			}
+			actions = append(actions, commandPostbackAction(T(locale, cmd.Label), cmd.Name))
+		}
+		for start := 0; start < len(actions); start += actionsPerColumn {
+			end := start + actionsPerColumn
+			if end > len(actions) {
+				end = len(actions)
+			}
+			columnActions := actions[start:end]
+			// every column of a carousel must have the same number of actions
+			for len(columnActions) < actionsPerColumn {
+				columnActions = append(columnActions, commandPostbackAction(T(locale, "功能列表"), "NBA"))
+			}
+			columns = append(columns, linebot.NewCarouselColumn(group.ImageURL, T(locale, group.Title), T(locale, group.Text), columnActions...))
+		}
+	}
+	// the command list is longer than the 400 characters of an alt text
+	return linebot.NewTemplateMessage(T(locale, "NBA功能列表"), linebot.NewCarouselTemplate(columns...))
+}
+
+func normalizeCommandText(text string) string {
+	return strings.ToUpper(strings.TrimSpace(text))
+}
+
+func parseNoArgs(rest string) (CommandArgs, error) {
+	return CommandArgs{}, nil
+}
+
+// parsePage parse the "@<page>" suffix of the paged commands
+func parsePage(rest string) (CommandArgs, error) {
+	args := CommandArgs{}
+	if !strings.HasPrefix(rest, "@") {
+		return args, nil
+	}
+	page, err := strconv.Atoi(strings.TrimPrefix(rest, "@"))
+	if err != nil {
+		return args, err
+	}
+	args.Page = page
+	return args, nil
+}
+
+func parseQuery(rest string) (CommandArgs, error) {
+	return CommandArgs{Query: strings.TrimSpace(rest)}, nil
+}
+
+// parsePagedQuery parse a query followed by an optional "@<page>"
+func parsePagedQuery(rest string) (CommandArgs, error) {
+	args := CommandArgs{Query: strings.TrimSpace(rest)}
+	if i := strings.LastIndex(args.Query, "@"); i >= 0 {
+		if page, err := strconv.Atoi(args.Query[i+1:]); err == nil {
+			args.Page, args.Query = page, strings.TrimSpace(args.Query[:i])
+		}
+	}
+	return args, nil
+}
+
+// logMessageMiddleware save every text message
+func (app *NBABotClient) logMessageMiddleware(next CommandHandler) CommandHandler {
+	return func(ctx *CommandContext) (linebot.SendingMessage, error) {
+		go func() {
+			if _, err := CreateMessage(Message{
+				UserID:    ctx.Source.UserID,
+				GroupID:   ctx.Source.GroupID,
+				RoomID:    ctx.Source.RoomID,
+				MessageID: ctx.Message.ID,
+				Message:   ctx.Message.Text,
+			}); err != nil {
+				log.Printf("error: %s\n", err.Error())
+			}
+		}()
+		return next(ctx)
+	}
+}
+
+// counterMiddleware count the uses of every command
+func (app *NBABotClient) counterMiddleware(next CommandHandler) CommandHandler {
+	return func(ctx *CommandContext) (linebot.SendingMessage, error) {
+		app.CounterIncs(ctx.Command.Name)
+		return next(ctx)
+	}
+}
+
+func (app *NBABotClient) registerCommands() {
+	r := NewCommandRegistry()
+	r.Use(app.logMessageMiddleware)
+	r.Use(app.counterMiddleware)
+
+	r.RegisterGroup(CommandGroup{Name: "score", Title: "NBA比分", Text: "賽事即時比分", ImageURL: app.allGameImgURL})
+	r.RegisterGroup(CommandGroup{Name: "standing", Title: "NBA戰績", Text: "分區戰績", ImageURL: app.standingImgURL})
+	r.RegisterGroup(CommandGroup{Name: "other", Title: "其它功能", Text: "說明及追蹤", ImageURL: app.nbaImgURL})
+
+	r.Register(&Command{
+		Name:        "NBA",
+		Description: "功能列表",
+		Handler:     app.cmdHelp,
+	})
+	r.Register(&Command{
+		Name: "#A2",
+		// legacy "分區戰績" button text
+		Aliases: []string{"#分區戰績"},
+		Handler: app.cmdStandingMenu,
+	})
+	r.Register(&Command{
+		Name:        CmdTodayGame,
+		Label:       TodayGameStr,
+		Description: "今日賽事比分",
+		Group:       "score",
+		Parse:       parsePage,
+		Handler:     app.cmdGameByDay(0),
+	})
+	r.Register(&Command{
+		Name:        CmdTomorrowGame,
+		Label:       TomorrowGameStr,
+		Description: "明日賽程",
+		Group:       "score",
+		Parse:       parsePage,
+		Handler:     app.cmdGameByDay(1),
+	})
+	r.Register(&Command{
+		Name:        CmdYesterdayGame,
+		Label:       YesterdayGameStr,
+		Description: "昨日賽事比分",
+		Group:       "score",
+		Parse:       parsePage,
+		Handler:     app.cmdGameByDay(-1),
+	})
+	r.Register(&Command{
+		Name:        ScheduleStr,
+		Aliases:     []string{"SCHEDULE"},
+		Label:       SchedulePickStr,
+		Description: "指定日期賽程，例如：賽程 3/15、賽程 週末",
+		Group:       "score",
+		Prefix:      true,
+		Parse:       parsePagedQuery,
+		Handler:     app.cmdSchedule,
+		Action: func(locale string) linebot.TemplateAction {
+			return scheduleDatePickerAction(locale)
+		},
+	})
+	r.Register(&Command{
+		Name:        CmdEasternConferenceStanding,
+		Label:       EasternConferenceStandingStr,
+		Description: "東區戰績圖",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/Eastern"),
+	})
+	r.Register(&Command{
+		Name:        CmdWesternConferenceStanding,
+		Label:       WesternConferenceStandingStr,
+		Description: "西區戰績圖",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/Western"),
+	})
+	r.Register(&Command{
+		Name:        CmdEasternDetailStanding,
+		Label:       EasternDetailStandingStr,
+		Description: "東區主客場、近10場及分差",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/Eastern?view=" + StandingViewDetail),
+	})
+	r.Register(&Command{
+		Name:        CmdWesternDetailStanding,
+		Label:       WesternDetailStandingStr,
+		Description: "西區主客場、近10場及分差",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/Western?view=" + StandingViewDetail),
+	})
+	r.Register(&Command{
+		Name:        DivisionStandingStr,
+		Label:       DivisionStandingStr,
+		Description: "分組戰績，例如：分組戰績 太平洋組",
+		Group:       "standing",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdDivisionStanding,
+	})
+	r.Register(&Command{
+		Name:        CmdGamePlayoffs,
+		Label:       GamePlayoffsStr,
+		Description: "季後賽對戰表",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/playoffs"),
+	})
+	r.Register(&Command{
+		Name:        CmdPlayoffPicture,
+		Label:       PlayoffPictureStr,
+		Description: "季後賽席位及附加賽形勢",
+		Group:       "standing",
+		Handler:     app.cmdImage("/standing/picture"),
+	})
+	r.Register(&Command{
+		Name:        CmdGamePlayerBoxExp,
+		Aliases:     []string{"#" + GamePlayerBoxExpStr},
+		Label:       GamePlayerBoxExpStr,
+		Description: "數據統計欄位說明",
+		Group:       "other",
+		Handler:     app.cmdImage("/gamecol/info"),
+	})
+	r.Register(&Command{
+		Name:        FollowListStr,
+		Label:       FollowListStr,
+		Description: "列出追蹤中的球隊",
+		Group:       "other",
+		Handler:     app.cmdFollowList,
+	})
+	r.Register(&Command{
+		Name:        LanguageStr,
+		Aliases:     []string{"LANGUAGE"},
+		Label:       LanguageStr,
+		Description: "切換語言，例如：語言 English",
+		Group:       "other",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdLanguage,
+	})
+	r.Register(&Command{
+		Name:        SettingsStr,
+		Aliases:     []string{"SETTINGS"},
+		Label:       SettingsStr,
+		Description: "查看及變更聊天室設定",
+		Group:       "other",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdSettings,
+	})
+	r.Register(&Command{
+		Name:        PlayerSearchStr,
+		Description: "今日球員數據，例如：球員 Curry",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdPlayer,
+	})
+	r.Register(&Command{
+		Name:        FollowTeamStr,
+		Description: "追蹤球隊，例如：追蹤 湖人",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdFollow,
+	})
+	r.Register(&Command{
+		Name:        UnfollowTeamStr,
+		Description: "取消追蹤球隊",
+		Prefix:      true,
+		Parse:       parseQuery,
+		Handler:     app.cmdUnfollow,
+	})
+	// texts matching no command may name a team
+	r.Fallback = &Command{
+		Name:    "其它",
+		Parse:   parseNoArgs,
+		Handler: app.cmdTeam,
+	}
+	app.commands = r
+}
+
+func (app *NBABotClient) cmdHelp(ctx *CommandContext) (linebot.SendingMessage, error) {
+	// the alt text of the carousel is no help to the text-only chats
+	if ctx.Settings.TextOnly {
+		return linebot.NewTextMessage(app.commands.HelpText(ctx.Locale)), nil
+	}
+	return app.commands.HelpCarousel(ctx.Locale), nil
+}
+
+func (app *NBABotClient) cmdStandingMenu(ctx *CommandContext) (linebot.SendingMessage, error) {
+	buttons := linebot.NewButtonsTemplate(
+		app.standingImgURL, T(ctx.Locale, "NBA功能列表"), T(ctx.Locale, "戰績"),
+		linebot.NewMessageAction(T(ctx.Locale, EasternConferenceStandingStr), CmdEasternConferenceStanding),
+		linebot.NewMessageAction(T(ctx.Locale, WesternConferenceStandingStr), CmdWesternConferenceStanding),
+		commandPostbackAction(T(ctx.Locale, DivisionStandingStr), DivisionStandingStr),
+	)
+	return linebot.NewTemplateMessage(T(ctx.Locale, "NBA戰績"), buttons), nil
+}
+
+// cmdGameByDay reply the games of today shifted by offset days, the days
+// starting at midnight in the zone of the chat
+func (app *NBABotClient) cmdGameByDay(offset int) CommandHandler {
+	return func(ctx *CommandContext) (linebot.SendingMessage, error) {
+		date := time.Now().In(ctx.Settings.Zone()).AddDate(0, 0, offset)
+		data, err := app.gamesOn(date, ctx.Locale)
+		if err != nil {
+			return nil, err
+		}
+		return app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
+			data:     favoriteFirst(data, parseGameInfoToGameScoreInfo(data), ctx.Settings.FavoriteTeamID),
+			cmd:      ctx.Command.Name,
+			page:     ctx.Args.Page,
+			showList: true,
+			locale:   ctx.Locale,
+			day:      data,
+			zone:     ctx.Settings.Zone(),
+		}), nil
+	}
+}
+
+// gamesOn return the games on the day of date in the zone of date. Today in
+// the default zone is the live scoreboard, shared with the poller.
+func (app *NBABotClient) gamesOn(date time.Time, locale string) (*GameInfo, error) {
+	// a chat may set the default zone by name, a different *time.Location
+	if date.Location().String() == _localZone.String() && isToday(date) {
+		return app.source.GetNBAGameToday(locale)
+	}
+	return app.source.GetNBAGameByDate(&date, locale)
+}
+
+// cmdImage reply the image served at path
+func (app *NBABotClient) cmdImage(path string) CommandHandler {
+	return func(ctx *CommandContext) (linebot.SendingMessage, error) {
+		return app.imageMessage(path, ctx.Settings), nil
+	}
+}
+
+// imageMessage build an image message of path drawn in the locale and the
+// zone of settings, versioned so LINE does not serve a stale copy
+func (app *NBABotClient) imageMessage(path string, settings ChatSettings) *linebot.ImageMessage {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	if locale := settings.ReplyLocale(); locale != DefaultLocale {
+		path += sep + "locale=" + url.QueryEscape(locale)
+		sep = "&"
+	}
+	if settings.Timezone != "" {
+		path += sep + "tz=" + url.QueryEscape(settings.Timezone)
+		sep = "&"
+	}
+	imageURL := app.appBaseURL + path + sep + "version=" + timestamp
+	return linebot.NewImageMessage(imageURL, imageURL)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var TeamCompareStr = "團隊數據比較"
+
+// TeamCompareStat is a row of the team comparison
+type TeamCompareStat struct {
+	Name  string
+	Value func(s TeamScore) float64
+	// Format default to the integer of Value
+	Format func(s TeamScore) string
+	// LowerIsBetter for turnovers and fouls
+	LowerIsBetter bool
+	// NoLeader rows are not highlighted
+	NoLeader bool
+}
+
+var TeamCompareStats = []TeamCompareStat{
+	{Name: "得分", Value: func(s TeamScore) float64 { return float64(s.Score) }},
+	{
+		Name:   "投籃",
+		Value:  func(s TeamScore) float64 { return s.Fgpct },
+		Format: func(s TeamScore) string { return fmt.Sprintf("%d-%d %.1f%%", s.Fgm, s.Fga, s.Fgpct) },
+	},
+	{
+		Name:   "三分",
+		Value:  func(s TeamScore) float64 { return s.Tppct },
+		Format: func(s TeamScore) string { return fmt.Sprintf("%d-%d %.1f%%", s.Tpm, s.Tpa, s.Tppct) },
+	},
+	{
+		Name:   "罰球",
+		Value:  func(s TeamScore) float64 { return s.Ftpct },
+		Format: func(s TeamScore) string { return fmt.Sprintf("%d-%d %.1f%%", s.Ftm, s.Fta, s.Ftpct) },
+	},
+	{Name: "籃板", Value: func(s TeamScore) float64 { return float64(s.Rebs) }},
+	{Name: "進攻籃板", Value: func(s TeamScore) float64 { return float64(s.OffRebs) }},
+	{Name: "防守籃板", Value: func(s TeamScore) float64 { return float64(s.DefRebs) }},
+	{Name: "助攻", Value: func(s TeamScore) float64 { return float64(s.Assists) }},
+	{Name: "抄截", Value: func(s TeamScore) float64 { return float64(s.Steals) }},
+	{Name: "阻攻", Value: func(s TeamScore) float64 { return float64(s.Blocks) }},
+	{Name: "失誤", Value: func(s TeamScore) float64 { return float64(s.Turnovers) }, LowerIsBetter: true},
+	{Name: "犯規", Value: func(s TeamScore) float64 { return float64(s.Fouls) }, LowerIsBetter: true},
+	{Name: "快攻得分", Value: func(s TeamScore) float64 { return float64(s.FastBreakPoints) }},
+	{Name: "禁區得分", Value: func(s TeamScore) float64 { return float64(s.PointsInPaint) }},
+	{Name: "失誤得分", Value: func(s TeamScore) float64 { return float64(s.PointsOffTurnovers) }},
+	{Name: "最大領先", Value: func(s TeamScore) float64 { return float64(s.BiggestLead) }},
+	{
+		Name:     "剩餘暫停",
+		Value:    func(s TeamScore) float64 { return float64(s.FullTimeoutsRemaining + s.ShortTimeoutsRemaining) },
+		NoLeader: true,
+	},
+}
+
+// teamCompareRows return the comparison table, home values on the left and
+// away values on the right, with the leading value of each row highlighted
+func teamCompareRows(pInfo *GamePlayerInfo, locale string) ([][]string, [][]bool) {
+	home := pInfo.Payload.HomeTeam
+	away := pInfo.Payload.AwayTeam
+	rows := [][]string{{home.Profile.Name, "", away.Profile.Name}}
+	highlight := [][]bool{{false, false, false}}
+	for _, stat := range TeamCompareStats {
+		format := stat.Format
+		if format == nil {
+			format = func(s TeamScore) string { return strconv.Itoa(int(stat.Value(s))) }
+		}
+		homeValue, awayValue := stat.Value(home.Score), stat.Value(away.Score)
+		if stat.LowerIsBetter {
+			homeValue, awayValue = -homeValue, -awayValue
+		}
+		rows = append(rows, []string{format(home.Score), T(locale, stat.Name), format(away.Score)})
+		highlight = append(highlight, []bool{
+			!stat.NoLeader && homeValue > awayValue,
+			false,
+			!stat.NoLeader && awayValue > homeValue,
+		})
+	}
+	return rows, highlight
+}
+
+func (app *NBABotClient) ParseTeamCompareToImgMessage(c *gin.Context, pInfo *GamePlayerInfo, locale string, zone *time.Location) {
+	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
+	title += fmt.Sprintf("  %s VS %s", pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.AwayTeam.Profile.Name)
+
+	rows, highlight := teamCompareRows(pInfo, locale)
+	opt := &TextToImageOpt{
+		SubTitle:  T(locale, TeamCompareStr),
+		TextData:  rows,
+		Highlight: highlight,
+	}
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		opt.SubTitle = T(locale, "未開賽")
+		opt.TextData = [][]string{}
+		opt.Highlight = nil
+	}
+	convertTextArrToTableImage(c, []*TextToImageOpt{opt}, title)
+}
+
+func (app *NBABotClient) getTeamCompare(c *gin.Context) {
+	locale := requestLocale(c)
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(c.Param("gameid"), locale)
+	if err != nil {
+		log.Printf("getTeamCompare GetNBAGamePlayerByGameID err: %v", err)
+		c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+		return
+	}
+	app.CounterIncs("團隊數據比較圖片")
+	app.ParseTeamCompareToImgMessage(c, pInfo, locale, requestZone(c))
+}
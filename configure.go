@@ -17,18 +17,21 @@ type Configuration struct {
 		Token  string `yaml:"token"`
 	} `yaml:"channel"`
 	Source     map[string]string
-	AppBaseURL string `yaml:"app_base_url"`
+	AppBaseURL string           `yaml:"app_base_url"`
+	Cache      CacheConfig      `yaml:"cache"`
+	HTTPClient HTTPClientConfig `yaml:"http_client"`
+	Poller     PollerConfig     `yaml:"poller"`
+	// TeamAliases map a nickname to a team abbreviation
+	TeamAliases map[string]string `yaml:"team_aliases"`
+	// Timezone is the IANA zone of the chats without one, Asia/Taipei by
+	// default
+	Timezone string `yaml:"timezone"`
 }
 
 var (
-	_config                  *Configuration
-	_localZone               *time.Location
-	_fontPath                string
-	nbaAPIScoresURL          string
-	nbaAPIGameSnapshotURL    string
-	nbaAPIGameSnapshotENURL  string
-	nbaConferenceStandingAPI string
-	nbaBracketURL            string
+	_config    *Configuration
+	_localZone *time.Location
+	_fontPath  string
 )
 
 func init() {
@@ -56,25 +59,26 @@ func init() {
 		_config.Channel.Secret = os.Getenv("ChannelSecret")
 		_config.Channel.Token = os.Getenv("ChannelAccessToken")
 		_config.Source = map[string]string{
-			"nba_url": os.Getenv("SourceNBAURL"),
+			"nba_url":  os.Getenv("SourceNBAURL"),
+			"type":     os.Getenv("SourceType"),
+			"file_dir": os.Getenv("SourceFileDir"),
+			// record and replay
+			"record_dir":   os.Getenv("SourceRecordDir"),
+			"replay_dir":   os.Getenv("SourceReplayDir"),
+			"replay_speed": os.Getenv("SourceReplaySpeed"),
 		}
 		_config.AppBaseURL = os.Getenv("AppBaseURL")
+		_config.Timezone = os.Getenv("Timezone")
 	}
 
-	var found bool
-	var nbaAPIURL string
-
-	nbaAPIURL, found = _config.Source["nba_url"]
-	if !found {
-		panic("config nba_url empty")
+	if _config.Source == nil {
+		panic("config source empty")
 	}
 
-	nbaAPIScoresURL = nbaAPIURL + "/stats2/scores/daily.json?countryCode=TW&locale=zh_TW&tz=%2B8"
-	nbaAPIGameSnapshotURL = nbaAPIURL + "/stats2/game/snapshot.json?countryCode=TW&locale=%s&gameId=%s"
-	nbaConferenceStandingAPI = nbaAPIURL + "/stats2/season/conferencestanding.json?locale=zh_TW"
-	nbaBracketURL = nbaAPIURL + "/stats2/playoff/bracket.json?locale=zh_TW"
-
-	_localZone, err = time.LoadLocation("Asia/Taipei")
+	if _config.Timezone == "" {
+		_config.Timezone = "Asia/Taipei"
+	}
+	_localZone, err = time.LoadLocation(_config.Timezone)
 	if err != nil {
 		panic(err)
 	}
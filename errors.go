@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Kinds of errors returned by a DataSource, match them with errors.Is
+var (
+	ErrUpstream = errors.New("upstream error")
+	ErrDecode   = errors.New("decode error")
+	ErrNotFound = errors.New("not found")
+	// ErrSourceUnavailable is returned when the upstream can not be reached,
+	// either because every retry failed or because the circuit breaker is open.
+	ErrSourceUnavailable = errors.New("data source unavailable")
+)
+
+// SourceError describe a failed DataSource call
+type SourceError struct {
+	Kind error
+	Op   string
+	Err  error
+}
+
+func (e *SourceError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: %v", e.Op, e.Kind)
+	}
+	return fmt.Sprintf("%s: %v: %v", e.Op, e.Kind, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+func (e *SourceError) Is(target error) bool {
+	return e.Kind == target
+}
+
+func newSourceError(kind error, op string, err error) *SourceError {
+	return &SourceError{Kind: kind, Op: op, Err: err}
+}
+
+// sourceErrorText map a DataSource error to the reply shown to users
+func sourceErrorText(err error, locale string) string {
+	switch {
+	case errors.Is(err, ErrSourceUnavailable):
+		return T(locale, SourceUnavailableStr)
+	case errors.Is(err, ErrNotFound):
+		return T(locale, SourceNotFoundStr)
+	default:
+		return T(locale, SourceErrorStr)
+	}
+}
+
+// sourceErrorStatus map a DataSource error to the status of an image request
+func sourceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrSourceUnavailable):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrUpstream), errors.Is(err, ErrDecode):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
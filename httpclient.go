@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type HTTPClientConfig struct {
+	Timeout time.Duration `yaml:"timeout"`
+	// Retries is the number of retries of a failed request, the default when
+	// unset and none when 0
+	Retries          *int          `yaml:"retries"`
+	RetryBackoff     time.Duration `yaml:"retry_backoff"`
+	BreakerThreshold int           `yaml:"breaker_threshold"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown"`
+}
+
+var defaultHTTPClientConfig = HTTPClientConfig{
+	Timeout:          5 * time.Second,
+	RetryBackoff:     200 * time.Millisecond,
+	BreakerThreshold: 5,
+	BreakerCooldown:  30 * time.Second,
+}
+
+// apiClient is the shared client for the stats API: every attempt has its own
+// deadline, 5xx and network errors are retried with jittered backoff, and
+// repeated failures open the circuit breaker.
+type apiClient struct {
+	client  *http.Client
+	config  HTTPClientConfig
+	retries int
+	breaker *circuitBreaker
+}
+
+const defaultHTTPRetries = 2
+
+func newAPIClient(config HTTPClientConfig) *apiClient {
+	if config.Timeout == 0 {
+		config.Timeout = defaultHTTPClientConfig.Timeout
+	}
+	retries := defaultHTTPRetries
+	if config.Retries != nil {
+		retries = *config.Retries
+	}
+	if retries < 0 {
+		// a negative value disables retries like 0
+		retries = 0
+	}
+	if config.RetryBackoff == 0 {
+		config.RetryBackoff = defaultHTTPClientConfig.RetryBackoff
+	} else if config.RetryBackoff < 0 {
+		// a negative value retries without waiting
+		config.RetryBackoff = 0
+	}
+	if config.BreakerThreshold == 0 {
+		config.BreakerThreshold = defaultHTTPClientConfig.BreakerThreshold
+	}
+	if config.BreakerCooldown == 0 {
+		config.BreakerCooldown = defaultHTTPClientConfig.BreakerCooldown
+	}
+	return &apiClient{
+		client:  &http.Client{},
+		config:  config,
+		retries: retries,
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+	}
+}
+
+// retryableError mark a failure worth another attempt
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (c *apiClient) Get(url string) ([]byte, error) {
+	op := "get " + url
+	if !c.breaker.Allow() {
+		return nil, newSourceError(ErrSourceUnavailable, op, fmt.Errorf("circuit breaker open"))
+	}
+	var err error
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(c.backoff(attempt))
+		}
+		var body []byte
+		body, err = c.get(url)
+		if err == nil {
+			c.breaker.Success()
+			return body, nil
+		}
+		log.Printf("error: get %s attempt %d: %v", url, attempt+1, err)
+		if _, ok := err.(*retryableError); !ok {
+			// the upstream answered, it is not down
+			c.breaker.Success()
+			return nil, err
+		}
+	}
+	c.breaker.Failure()
+	return nil, newSourceError(ErrSourceUnavailable, op, err)
+}
+
+func (c *apiClient) get(url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, newSourceError(ErrUpstream, "get "+url, err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, &retryableError{err}
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &retryableError{err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("status code error %d: %s", resp.StatusCode, truncate(string(body), 200))
+		switch {
+		case resp.StatusCode >= 500:
+			return nil, &retryableError{err}
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, newSourceError(ErrNotFound, "get "+url, err)
+		default:
+			return nil, newSourceError(ErrUpstream, "get "+url, err)
+		}
+	}
+	return body, nil
+}
+
+// maxRetryBackoff caps the wait before a retry
+const maxRetryBackoff = 30 * time.Second
+
+// backoff return RetryBackoff * 2^(attempt-1) up to maxRetryBackoff, jittered
+// by ±50%
+func (c *apiClient) backoff(attempt int) time.Duration {
+	d := c.config.RetryBackoff
+	// doubling stops at the cap, so it can not overflow
+	for i := 1; i < attempt && d < maxRetryBackoff; i++ {
+		d *= 2
+	}
+	if d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
+
+// circuitBreaker open after threshold consecutive failures and reject calls
+// until cooldown passes, then let a single trial call through.
+type circuitBreaker struct {
+	sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+func (b *circuitBreaker) Allow() bool {
+	b.Lock()
+	defer b.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.trial {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *circuitBreaker) Success() {
+	b.Lock()
+	b.failures = 0
+	b.trial = false
+	b.Unlock()
+}
+
+func (b *circuitBreaker) Failure() {
+	b.Lock()
+	b.failures++
+	b.trial = false
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+	b.Unlock()
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
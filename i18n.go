@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locales of the message catalogs, also sent to the upstream API
+const (
+	LocaleZhTW    = "zh_TW"
+	LocaleEN      = "en"
+	DefaultLocale = LocaleZhTW
+)
+
+// Catalog translate the zh_TW strings of the bot, which are the message
+// keys, to a locale
+type Catalog map[string]string
+
+// Catalogs of each supported locale. zh_TW is the language of the source so
+// its catalog is empty, and a string missing from a catalog is shown in
+// zh_TW.
+var Catalogs = map[string]Catalog{
+	LocaleZhTW: {},
+	LocaleEN:   catalogEN,
+}
+
+// LocaleNames are the names a chat can choose its locale by
+var LocaleNames = map[string]string{
+	"ZH_TW":   LocaleZhTW,
+	"ZH":      LocaleZhTW,
+	"中文":      LocaleZhTW,
+	"EN":      LocaleEN,
+	"ENGLISH": LocaleEN,
+	"英文":      LocaleEN,
+}
+
+// LocaleDisplayNames are the buttons of the language menu
+var LocaleDisplayNames = []struct {
+	Locale string
+	Name   string
+}{
+	{LocaleZhTW, "中文"},
+	{LocaleEN, "English"},
+}
+
+// T translate key to locale
+func T(locale string, key string) string {
+	if text, ok := Catalogs[locale][key]; ok {
+		return text
+	}
+	return key
+}
+
+// Tf translate the format key to locale and format it with args
+func Tf(locale string, format string, args ...interface{}) string {
+	return fmt.Sprintf(T(locale, format), args...)
+}
+
+// parseLocale return the locale of a LocaleNames name or a locale
+func parseLocale(text string) (string, bool) {
+	locale, ok := LocaleNames[strings.ToUpper(strings.TrimSpace(text))]
+	return locale, ok
+}
+
+// validLocale return locale if it has a catalog, DefaultLocale otherwise
+func validLocale(locale string) string {
+	if _, ok := Catalogs[locale]; ok {
+		return locale
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	GameLeadersStr    = "比賽領袖"
+	SeasonLeadersStr  = "賽前預覽 - 本季平均"
+	PointLeaderFormat = "得分王 %s / %s"
+	// SeasonPointLeaderFormat is the points line of the season leaders
+	// before tip-off
+	SeasonPointLeaderFormat = "場均得分 %s / %s"
+)
+
+// leaderRow is one stat of the leaders table, formatted for both teams
+type leaderRow struct {
+	name string
+	home string
+	away string
+}
+
+func gameLeaderValue(leader GameLeader, value int) string {
+	if leader.Profile.PlayerID == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s %d", leader.Profile.DisplayName, value)
+}
+
+func seasonLeaderValue(leader SeasonLeader, value float64) string {
+	if leader.Profile.PlayerID == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s %.1f", leader.Profile.DisplayName, value)
+}
+
+// gameLeaderRows return the points, rebounds and assists leaders of the game,
+// or of the season for games not started yet
+func gameLeaderRows(pInfo *GamePlayerInfo, locale string) []leaderRow {
+	home := pInfo.Payload.HomeTeam
+	away := pInfo.Payload.AwayTeam
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		return []leaderRow{
+			{T(locale, "得分"), seasonLeaderValue(home.PointSeasonLeader, home.PointSeasonLeader.StatAverage.PointsPg), seasonLeaderValue(away.PointSeasonLeader, away.PointSeasonLeader.StatAverage.PointsPg)},
+			{T(locale, "籃板"), seasonLeaderValue(home.ReboundSeasonLeader, home.ReboundSeasonLeader.StatAverage.RebsPg), seasonLeaderValue(away.ReboundSeasonLeader, away.ReboundSeasonLeader.StatAverage.RebsPg)},
+			{T(locale, "助攻"), seasonLeaderValue(home.AssistSeasonLeader, home.AssistSeasonLeader.StatAverage.AssistsPg), seasonLeaderValue(away.AssistSeasonLeader, away.AssistSeasonLeader.StatAverage.AssistsPg)},
+		}
+	}
+	return []leaderRow{
+		{T(locale, "得分"), gameLeaderValue(home.PointGameLeader, home.PointGameLeader.StatTotal.Points), gameLeaderValue(away.PointGameLeader, away.PointGameLeader.StatTotal.Points)},
+		{T(locale, "籃板"), gameLeaderValue(home.ReboundGameLeader, home.ReboundGameLeader.StatTotal.Rebs), gameLeaderValue(away.ReboundGameLeader, away.ReboundGameLeader.StatTotal.Rebs)},
+		{T(locale, "助攻"), gameLeaderValue(home.AssistGameLeader, home.AssistGameLeader.StatTotal.Assists), gameLeaderValue(away.AssistGameLeader, away.AssistGameLeader.StatTotal.Assists)},
+	}
+}
+
+// gameLeadersText is the leaders as plain text, one stat per line
+func gameLeadersText(pInfo *GamePlayerInfo, locale string) string {
+	lines := []string{}
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		lines = append(lines, T(locale, SeasonLeadersStr))
+	}
+	for _, row := range gameLeaderRows(pInfo, locale) {
+		lines = append(lines, fmt.Sprintf("%s %s | %s", row.name, row.home, row.away))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pointLeadersText is the short points leaders line of the game carousel
+func pointLeadersText(home GameLeader, away GameLeader, locale string) string {
+	if home.Profile.PlayerID == "" && away.Profile.PlayerID == "" {
+		return ""
+	}
+	return Tf(locale, PointLeaderFormat,
+		gameLeaderValue(home, home.StatTotal.Points),
+		gameLeaderValue(away, away.StatTotal.Points),
+	)
+}
+
+// seasonPointLeadersText is the short season points leaders line of the game
+// carousel before tip-off, from the season leaders of gameLeaderRows. The
+// players go by their last name when full names make it longer than maxLen.
+func seasonPointLeadersText(pInfo *GamePlayerInfo, locale string, maxLen int) string {
+	if pInfo.Payload.Boxscore.Status != GameStatusScheduled {
+		return ""
+	}
+	points := gameLeaderRows(pInfo, locale)[0]
+	if points.home == "-" && points.away == "-" {
+		return ""
+	}
+	text := Tf(locale, SeasonPointLeaderFormat, points.home, points.away)
+	if utf8.RuneCountInString(text) <= maxLen {
+		return text
+	}
+	home := pInfo.Payload.HomeTeam.PointSeasonLeader
+	away := pInfo.Payload.AwayTeam.PointSeasonLeader
+	return Tf(locale, SeasonPointLeaderFormat,
+		seasonLeaderShortValue(home, home.StatAverage.PointsPg),
+		seasonLeaderShortValue(away, away.StatAverage.PointsPg),
+	)
+}
+
+func seasonLeaderShortValue(leader SeasonLeader, value float64) string {
+	if leader.Profile.LastName == "" {
+		return seasonLeaderValue(leader, value)
+	}
+	return fmt.Sprintf("%s %.1f", leader.Profile.LastName, value)
+}
+
+// carouselLeadersText is the points leaders line of a game on the carousel:
+// of the game once it started, of the season before, read from the snapshot
+// of the game as the scoreboard has no season leaders. The season line is
+// shortened to maxLen when it can be.
+func (app *NBABotClient) carouselLeadersText(val *GameScoreInfo, locale string, maxLen int) string {
+	if val.Boxscore.Status != GameStatusScheduled {
+		return pointLeadersText(val.HomePointLeader, val.AwayPointLeader, locale)
+	}
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(val.GameID, locale)
+	if err != nil {
+		log.Printf("carouselLeadersText GetNBAGamePlayerByGameID err: %v", err)
+		return ""
+	}
+	return seasonPointLeadersText(pInfo, locale, maxLen)
+}
+
+func (app *NBABotClient) ParseGameLeadersToImgMessage(c *gin.Context, pInfo *GamePlayerInfo, locale string, zone *time.Location) {
+	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
+	title += fmt.Sprintf("  %s VS %s", pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.AwayTeam.Profile.Name)
+
+	rows := [][]string{{pInfo.Payload.HomeTeam.Profile.Name, "", pInfo.Payload.AwayTeam.Profile.Name}}
+	for _, row := range gameLeaderRows(pInfo, locale) {
+		rows = append(rows, []string{row.home, row.name, row.away})
+	}
+	subTitle := T(locale, GameLeadersStr)
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		subTitle = T(locale, SeasonLeadersStr)
+	}
+	convertTextArrToTableImage(c, []*TextToImageOpt{
+		{
+			SubTitle: subTitle,
+			TextData: rows,
+		},
+	}, title)
+}
+
+func (app *NBABotClient) getGameLeaders(c *gin.Context) {
+	locale := requestLocale(c)
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(c.Param("gameid"), locale)
+	if err != nil {
+		log.Printf("getGameLeaders GetNBAGamePlayerByGameID err: %v", err)
+		c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+		return
+	}
+	app.CounterIncs("比賽領袖圖片")
+	app.ParseGameLeadersToImgMessage(c, pInfo, locale, requestZone(c))
+}
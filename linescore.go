@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	GameMenuStr  = "比賽選單"
+	LineScoreStr = "各節比分"
+)
+
+// periodsPlayed return the number of periods of the line score, the four
+// quarters and the overtimes actually played
+func periodsPlayed(box GameBoxscore) int {
+	period, _ := strconv.Atoi(box.Period)
+	if period < regulationPeriods {
+		return regulationPeriods
+	}
+	return period
+}
+
+// periodScores return the points of the first periods of score
+func periodScores(score TeamScore, periods int) []int {
+	all := []int{
+		score.Q1Score, score.Q2Score, score.Q3Score, score.Q4Score,
+		score.Ot1Score, score.Ot2Score, score.Ot3Score, score.Ot4Score, score.Ot5Score,
+		score.Ot6Score, score.Ot7Score, score.Ot8Score, score.Ot9Score, score.Ot10Score,
+	}
+	if periods > len(all) {
+		periods = len(all)
+	}
+	return all[:periods]
+}
+
+func periodName(period int) string {
+	if period <= regulationPeriods {
+		return fmt.Sprintf("Q%d", period)
+	}
+	return fmt.Sprintf("OT%d", period-regulationPeriods)
+}
+
+// lineScoreRows return the line score table: a header row, then the home and
+// away rows. Periods not started yet are shown as "-".
+func lineScoreRows(pInfo *GamePlayerInfo, locale string) [][]string {
+	box := pInfo.Payload.Boxscore
+	periods := periodsPlayed(box)
+	current, _ := strconv.Atoi(box.Period)
+	if box.Status == GameStatusFinal {
+		current = periods
+	}
+
+	header := []string{T(locale, "球隊")}
+	for period := 1; period <= periods; period++ {
+		header = append(header, periodName(period))
+	}
+	header = append(header, "T")
+
+	row := func(name string, score TeamScore, total int) []string {
+		r := []string{name}
+		for i, points := range periodScores(score, periods) {
+			if i+1 > current {
+				r = append(r, "-")
+				continue
+			}
+			r = append(r, strconv.Itoa(points))
+		}
+		return append(r, strconv.Itoa(total))
+	}
+	return [][]string{
+		header,
+		row(pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.HomeTeam.Score, box.HomeScore),
+		row(pInfo.Payload.AwayTeam.Profile.Name, pInfo.Payload.AwayTeam.Score, box.AwayScore),
+	}
+}
+
+// lineScoreText is the line score as plain text, one team per line
+func lineScoreText(pInfo *GamePlayerInfo, locale string) string {
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		return T(locale, "未開賽")
+	}
+	rows := lineScoreRows(pInfo, locale)
+	lines := []string{}
+	for _, row := range rows {
+		lines = append(lines, strings.Join(row, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (app *NBABotClient) ParseLineScoreToImgMessage(c *gin.Context, pInfo *GamePlayerInfo, locale string, zone *time.Location) {
+	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
+	title += fmt.Sprintf("  %s VS %s", pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.AwayTeam.Profile.Name)
+
+	opt := &TextToImageOpt{
+		SubTitle: T(locale, LineScoreStr),
+		TextData: lineScoreRows(pInfo, locale),
+	}
+	if pInfo.Payload.Boxscore.Status == GameStatusScheduled {
+		opt.SubTitle = T(locale, "未開賽")
+		opt.TextData = [][]string{}
+	}
+	convertTextArrToTableImage(c, []*TextToImageOpt{opt}, title)
+}
+
+func (app *NBABotClient) getLineScore(c *gin.Context) {
+	locale := requestLocale(c)
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(c.Param("gameid"), locale)
+	if err != nil {
+		log.Printf("getLineScore GetNBAGamePlayerByGameID err: %v", err)
+		c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+		return
+	}
+	app.CounterIncs("各節比分圖片")
+	app.ParseLineScoreToImgMessage(c, pInfo, locale, requestZone(c))
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
 	"os"
@@ -12,20 +13,44 @@ import (
 )
 
 func main() {
+	capture := flag.Bool("capture", false, "record today's upstream responses into source.record_dir and exit")
+	captureInterval := flag.Duration("capture-interval", 30*time.Second, "poll interval while capturing")
+	flag.Parse()
+	if *capture {
+		source := NewHTTPDataSource(_config.Source["nba_url"], _config.HTTPClient)
+		if dir := _config.Source["record_dir"]; dir != "" {
+			source.recorder = NewRecorder(dir)
+		}
+		if err := RunCapture(source, *captureInterval); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	log.Print("Server Start...")
 	repo = NewDB()
 	Migrate()
-	app, err := NewNBABotClient(_config.Channel.Secret, _config.Channel.Token, _config.AppBaseURL)
+	source, err := NewDataSource(_config)
+	if err != nil {
+		log.Fatal(err)
+	}
+	source = NewCachedDataSource(source, _config.Cache)
+	poller := NewGamePoller(source, _config.Poller)
+	stopPoller := make(chan struct{})
+	go poller.Run(stopPoller)
+
+	app, err := NewNBABotClient(_config.Channel.Secret, _config.Channel.Token, _config.AppBaseURL, source, NewTeamDirectory(source, _config.TeamAliases))
 	if err != nil {
 		log.Fatal(err)
 	}
+	poller.Subscribe(app.NotifyGameEvent)
 
 	router := gin.Default()
 	router.Static("/static", "./static")
 	router.Static("/downloaded", "./downloaded")
 	router.POST("/callback", app.Callback)
 	router.GET("/gamecol/info", app.getGameColumnInfo)
-	router.GET("/game/:gameid/:type", app.getGamePlayInfoEN)
+	router.GET("/game/:gameid/:type", app.getGameView)
 	router.GET("/standing/:conference", app.getStandingInfo)
 
 	// admin
@@ -48,10 +73,11 @@ func main() {
 
 	// Wait for interrupt signal to gracefully shutdown the server with
 	// a timeout of 5 seconds.
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	log.Println("Shutdown Server ...")
+	close(stopPoller)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
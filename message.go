@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"image"
+	"image/color"
 	"image/draw"
 	"image/png"
 	"io/ioutil"
@@ -13,10 +14,10 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/freetype/truetype"
@@ -37,6 +38,9 @@ var (
 	WesternConferenceStandingStr = "西區戰績"
 	GamePlayerBoxExpStr          = "數據統計說明"
 	GamePlayoffsStr              = "季後賽戰績"
+	SourceUnavailableStr         = "資料來源暫時無法使用，請稍後再試"
+	SourceNotFoundStr            = "查無資料"
+	SourceErrorStr               = "資料來源回傳錯誤，請稍後再試"
 	CmdTodayGame                 = _cmd_prefix + TodayGameStr
 	CmdTomorrowGame              = _cmd_prefix + TomorrowGameStr
 	CmdYesterdayGame             = _cmd_prefix + YesterdayGameStr
@@ -46,18 +50,10 @@ var (
 	CmdGamePlayoffs              = _cmd_prefix + GamePlayoffsStr
 )
 
-var CmdArray = []string{
-	CmdTodayGame,
-	CmdTomorrowGame,
-	CmdYesterdayGame,
-	CmdEasternConferenceStanding,
-	CmdWesternConferenceStanding,
-	CmdGamePlayerBoxExp,
-	CmdGamePlayoffs,
-}
-
 type NBABotClient struct {
-	bot *linebot.Client
+	bot    *linebot.Client
+	source DataSource
+	teams  *TeamDirectory
 	sync.RWMutex
 	appBaseURL     string
 	standingImgURL string
@@ -66,9 +62,13 @@ type NBABotClient struct {
 	downloadDir    string
 	commandCounter map[string]int
 	initTime       *time.Time
+	commands       *CommandRegistry
+	// botName is the display name of the bot, read once when the client is
+	// created
+	botName string
 }
 
-func NewNBABotClient(channelSecret, channelToken, appBaseURL string) (*NBABotClient, error) {
+func NewNBABotClient(channelSecret, channelToken, appBaseURL string, source DataSource, teams *TeamDirectory) (*NBABotClient, error) {
 	bot, err := linebot.New(
 		channelSecret,
 		channelToken,
@@ -86,21 +86,30 @@ func NewNBABotClient(channelSecret, channelToken, appBaseURL string) (*NBABotCli
 		}
 	}
 
-	cmdCounter := map[string]int{}
-	for _, cmd := range CmdArray {
-		cmdCounter[cmd] = 0
-	}
 	imgPath := appBaseURL + "/static/buttons/"
-	return &NBABotClient{
+	app := &NBABotClient{
 		bot:            bot,
+		source:         source,
+		teams:          teams,
 		appBaseURL:     appBaseURL,
 		downloadDir:    downloadDir,
-		commandCounter: cmdCounter,
+		commandCounter: map[string]int{},
 		initTime:       &now,
 		standingImgURL: imgPath + "standing.png",
 		allGameImgURL:  imgPath + "allgame.png",
 		nbaImgURL:      imgPath + "nba.png",
-	}, nil
+	}
+	// mentions of the bot are found by its display name
+	if info, err := bot.GetBotInfo().Do(); err != nil {
+		log.Printf("GetBotInfo error: %v", err)
+	} else {
+		app.botName = info.DisplayName
+	}
+	app.registerCommands()
+	for _, cmd := range app.commands.Commands() {
+		app.commandCounter[cmd.Name] = 0
+	}
+	return app, nil
 }
 
 func (app *NBABotClient) Callback(c *gin.Context) {
@@ -128,7 +137,7 @@ func (app *NBABotClient) Callback(c *gin.Context) {
 			}
 		case linebot.EventTypePostback:
 			data := event.Postback.Data
-			app.handlePostBack(data, event.ReplyToken)
+			app.handlePostBack(data, event.Postback.Params, event.ReplyToken, event.Source)
 		}
 	}
 }
@@ -145,6 +154,10 @@ func (app *NBABotClient) Statistic(c *gin.Context) {
 	for _, key := range keys {
 		response += fmt.Sprintf("%s : %d\n", key, app.commandCounter[key])
 	}
+	if cached, ok := app.source.(*CachedDataSource); ok {
+		stats := cached.CacheStats()
+		response += fmt.Sprintf("快取命中 : %d\n快取未命中 : %d\n快取筆數 : %d\n", stats.Hits, stats.Misses, stats.Entries)
+	}
 	response += fmt.Sprintf("統計開始時間： %s", app.initTime.Format(DATE_TIME_LAYOUT))
 
 	fmt.Fprintf(c.Writer, "%s", response)
@@ -157,176 +170,28 @@ func (app *NBABotClient) CounterIncs(key string) {
 }
 
 func (app *NBABotClient) handleText(message *linebot.TextMessage, replyToken string, source *linebot.EventSource) error {
-	go func() {
-		if _, err := CreateMessage(Message{
-			UserID:    source.UserID,
-			GroupID:   source.GroupID,
-			RoomID:    source.RoomID,
-			MessageID: message.ID,
-			Message:   message.Text,
-		}); err != nil {
-			log.Printf("error: %s\n", err.Error())
-		}
-	}()
-	var sendMsg linebot.SendingMessage
-	var err error
-	recMsg := strings.Trim(message.Text, " ")
-	recMsg = strings.ToUpper(recMsg)
-	recMsgArr := strings.Split(recMsg, "@")
-	page := 0
-	if len(recMsgArr) > 1 {
-		page, err = strconv.Atoi(recMsgArr[1])
-		if err != nil {
-			return err
-		}
-	}
-	switch recMsgArr[0] {
-	case "NBA":
-		column1 := linebot.NewCarouselColumn(
-			app.allGameImgURL, "NBA比分", "賽事即時比分",
-			linebot.NewPostbackAction(TodayGameStr, CmdTodayGame, CmdTodayGame, ""),
-			linebot.NewPostbackAction(TomorrowGameStr, CmdTomorrowGame, CmdTomorrowGame, ""),
-			linebot.NewPostbackAction(YesterdayGameStr, CmdYesterdayGame, CmdYesterdayGame, ""),
-		)
-		column2 := linebot.NewCarouselColumn(
-			app.standingImgURL, "NBA戰績", "分區戰績",
-			linebot.NewPostbackAction("分區戰績", "#分區戰績", "#分區戰績", ""),
-			linebot.NewPostbackAction(EasternConferenceStandingStr, CmdEasternConferenceStanding, CmdEasternConferenceStanding, ""),
-			linebot.NewPostbackAction(WesternConferenceStandingStr, CmdWesternConferenceStanding, CmdWesternConferenceStanding, ""),
-		)
-		columns := []*linebot.CarouselColumn{
-			column1,
-			column2,
-		}
-		cmdLine := strings.Join(CmdArray, " | ")
-
-		template := linebot.NewCarouselTemplate(columns...)
-		sendMsg = linebot.NewTemplateMessage("支援命令: \n   "+cmdLine, template)
-		app.CounterIncs(recMsg)
-	case "#a2":
-		buttons := linebot.NewButtonsTemplate(
-			app.standingImgURL, "NBA功能列表", "戰績",
-			linebot.NewMessageAction("東區戰績", CmdEasternConferenceStanding),
-			linebot.NewMessageAction("西區戰績", CmdWesternConferenceStanding),
-		)
-		cmdLine := strings.Join(CmdArray, " | ")
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewTemplateMessage("支援命令: \n   "+cmdLine, buttons),
-		).Do(); err != nil {
-			return err
-		}
-		app.CounterIncs(recMsg)
+	settings := app.chatSettings(chatIDOf(source))
+	text, mentioned := message.Text, false
+	// paged commands like "a1今日賽事@2" are not mentions
+	if settings.MentionOnly || strings.HasPrefix(text, "@") {
+		text, mentioned = app.stripMention(text)
+	}
+	// without the bot name no text can be told a mention, answer them all
+	if settings.MentionOnly && app.botName != "" && source.Type != linebot.EventSourceTypeUser && !mentioned {
 		return nil
-	case CmdTodayGame:
-		data, err := GetNBAGameToday()
-		if err != nil {
-			log.Printf("GetNBAGameToday error : %v", err)
-		}
-		sInfo := parseGameInfoToGameScoreInfo(data)
-		sendMsg = app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
-			data:     sInfo,
-			cmd:      recMsgArr[0],
-			page:     page,
-			showList: true,
-		})
-		app.CounterIncs(recMsg)
-	case CmdTomorrowGame:
-		today, err := GetLocalTime(time.Now())
-		if err != nil {
-			log.Printf("GetLocalTimeNow error: %v", err)
-		}
-		tomorrow := today.Add(24 * time.Hour)
-		data, err := GetNBAGameByDate(&tomorrow)
-		if err != nil {
-			log.Printf("GetNBAGameByDate error :%v, %v", tomorrow, err)
-		}
-		sInfo := parseGameInfoToGameScoreInfo(data)
-		sendMsg = app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
-			data:     sInfo,
-			cmd:      recMsgArr[0],
-			page:     page,
-			showList: true,
-		})
-		app.CounterIncs(recMsg)
-	case CmdYesterdayGame:
-		today, err := GetLocalTime(time.Now())
-		if err != nil {
-			log.Printf("GetLocalTimeNow error: %v", err)
-		}
-		tomorrow := today.Add(-24 * time.Hour)
-		data, err := GetNBAGameByDate(&tomorrow)
-		if err != nil {
-			log.Printf("GetNBAGameByDate error :%v, %v", tomorrow, err)
-		}
-		sInfo := parseGameInfoToGameScoreInfo(data)
-		sendMsg = app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
-			data:     sInfo,
-			cmd:      recMsgArr[0],
-			page:     page,
-			showList: true,
-		})
-		app.CounterIncs(recMsg)
-
-	case CmdEasternConferenceStanding:
-		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-		imageUrl := app.appBaseURL + "/standing/Eastern?version=" + timestamp
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewImageMessage(imageUrl, imageUrl),
-		).Do(); err != nil {
-			log.Printf("ParseConferenceStandingToMessage imageUrl err: %v", err)
-		}
-		app.CounterIncs(recMsg)
-	case CmdWesternConferenceStanding:
-		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-		imageUrl := app.appBaseURL + "/standing/Western?version=" + timestamp
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewImageMessage(imageUrl, imageUrl),
-		).Do(); err != nil {
-			log.Printf("ParseConferenceStandingToMessage imageUrl err: %v", err)
-		}
-		app.CounterIncs(recMsg)
-
-	// case "profile":
-	// 	if source.UserID != "" {
-	// 		profile, err := app.bot.GetProfile(source.UserID).Do()
-	// 		if err != nil {
-	// 			return app.replyText(replyToken, err.Error())
-	// 		}
-	// 		if _, err := app.bot.ReplyMessage(
-	// 			replyToken,
-	// 			linebot.NewTextMessage("Display name: "+profile.DisplayName),
-	// 			linebot.NewTextMessage("Status message: "+profile.StatusMessage),
-	// 		).Do(); err != nil {
-	// 			return err
-	// 		}
-	// 	} else {
-	// 		return app.replyText(replyToken, "Bot can't use profile API without user ID")
-	// 	}
-	case CmdGamePlayerBoxExp:
-		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-		imageUrl := app.appBaseURL + "/gamecol/info?version=" + timestamp
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewImageMessage(imageUrl, imageUrl),
-		).Do(); err != nil {
-			log.Printf("CmdGamePlayerBoxExp imageUrl err: %v", err)
-		}
-		app.CounterIncs(recMsg)
-	case CmdGamePlayoffs:
-		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-		imageUrl := app.appBaseURL + "/standing/playoffs?version=" + timestamp
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewImageMessage(imageUrl, imageUrl),
-		).Do(); err != nil {
-			log.Printf("CmdGamePlayoffs imageUrl err: %v", err)
-		}
-		app.CounterIncs(recMsg)
-	default:
-		app.CounterIncs("其它")
+	}
+	if mentioned {
+		stripped := *message
+		stripped.Text = text
+		message = &stripped
+	}
+	sendMsg, err := app.commands.Dispatch(message, source, settings)
+	if err != nil {
+		log.Printf("command %q error: %v", message.Text, err)
+		sendMsg = linebot.NewTextMessage(commandErrorText(err, settings.ReplyLocale()))
+	}
+	if sendMsg != nil && settings.TextOnly {
+		sendMsg = textOnlyMessage(sendMsg)
 	}
 	if sendMsg != nil {
 		if _, err := app.bot.ReplyMessage(
@@ -339,59 +204,6 @@ func (app *NBABotClient) handleText(message *linebot.TextMessage, replyToken str
 	return nil
 }
 
-func (app *NBABotClient) handlePostBack(data string, replyToken string) {
-	dataArr := strings.Split(data, "@")
-	if len(dataArr) != 3 {
-		return
-	}
-	msgType := dataArr[0]
-	action := dataArr[1]
-	payload := dataArr[2]
-
-	switch msgType {
-	case "player":
-		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-		imageURL := app.appBaseURL + "/game/" + payload + "/" + action + "?version=" + timestamp
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewImageMessage(imageURL, imageURL),
-		).Do(); err != nil {
-			log.Printf("GetNBAGamePlayerByGameID imageURL err: %v", err)
-		}
-		app.CounterIncs("#比賽數據統計")
-	case "score":
-		pInfo, err := GetNBAGamePlayerByGameID(payload, "zh_TW")
-		if err != nil {
-			log.Printf("score GetNBAGamePlayerByGameID err: %v", err)
-			return
-		}
-
-		sInfo := parseGamePlayerInfoToGameScoreInfo(pInfo)
-		sendMsg := app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
-			data:     sInfo,
-			showList: false,
-		})
-		if sendMsg != nil {
-			if _, err := app.bot.ReplyMessage(
-				replyToken,
-				sendMsg,
-			).Do(); err != nil {
-				return
-			}
-		}
-		app.CounterIncs("更新比分")
-	case "echo":
-		msg := payload
-		if _, err := app.bot.ReplyMessage(
-			replyToken,
-			linebot.NewTextMessage(msg),
-		).Do(); err != nil {
-			return
-		}
-	}
-
-}
-
 func (app *NBABotClient) replyText(replyToken, text string) error {
 	if _, err := app.bot.ReplyMessage(
 		replyToken,
@@ -405,10 +217,18 @@ func (app *NBABotClient) replyText(replyToken, text string) error {
 type GameScoreInfo struct {
 	Boxscore      GameBoxscore
 	GameID        string
-	GameTime      string
+	UtcMillis     string
 	HomeTeamName  string
 	AwayTeamName  string
 	HighlightsURL string
+	// HomePointLeader and AwayPointLeader are empty before tip-off
+	HomePointLeader GameLeader
+	AwayPointLeader GameLeader
+}
+
+// gameTime is the start time of the game in locale and zone
+func (val *GameScoreInfo) gameTime(locale string, zone *time.Location) string {
+	return UtcMillis2TimeString(val.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
 }
 
 type ParseGameScoreOpt struct {
@@ -416,14 +236,24 @@ type ParseGameScoreOpt struct {
 	page     int
 	cmd      string
 	showList bool
+	locale   string
+	// zone is the timezone of the start times
+	zone *time.Location
+	// day is the scoreboard data was parsed from, a day without games
+	// replies its next game day
+	day *GameInfo
 }
 
 func (app *NBABotClient) ParseGameScoreInfoToMessage(opt *ParseGameScoreOpt) linebot.SendingMessage {
 	data := opt.data
+	locale := opt.locale
 	gameNum := len(data)
 	page := opt.page
 	if gameNum == 0 {
-		return linebot.NewTextMessage("當日無賽事")
+		if opt.day != nil {
+			return noGameMessage(opt.day, locale, opt.zone)
+		}
+		return linebot.NewTextMessage(T(locale, NoGameStr))
 	}
 	if page <= 0 {
 		page = 1
@@ -446,6 +276,8 @@ func (app *NBABotClient) ParseGameScoreInfoToMessage(opt *ParseGameScoreOpt) lin
 				page:     page - 1,
 				cmd:      opt.cmd,
 				showList: true,
+				locale:   locale,
+				zone:     opt.zone,
 			})
 		}
 		if endIndex > gameNum {
@@ -460,266 +292,149 @@ func (app *NBABotClient) ParseGameScoreInfoToMessage(opt *ParseGameScoreOpt) lin
 	if opt.showList && page == 1 {
 		message += fmt.Sprintf("%s：%s\n", listBtnText, listBtnCmd)
 		firstColumn := linebot.NewCarouselColumn(
-			app.allGameImgURL, "賽事選單", "賽事選單",
-			linebot.NewPostbackAction(listBtnText, listBtnText, listBtnCmd, ""),
-			linebot.NewPostbackAction("數據統計說明", "數據統計說明", "#數據統計說明", ""),
-			linebot.NewPostbackAction("功能列表", "功能列表", "NBA", ""),
+			app.allGameImgURL, T(locale, "賽事選單"), T(locale, "賽事選單"),
+			commandPostbackAction(listBtnText, listBtnCmd),
+			commandPostbackAction(T(locale, GamePlayerBoxExpStr), CmdGamePlayerBoxExp),
+			commandPostbackAction(T(locale, "功能列表"), "NBA"),
 		)
 		columns = append(columns, firstColumn)
 	}
-	message += "     主隊 : 客隊\n"
+	message += T(locale, "     主隊 : 客隊\n")
+	menuItems := []*linebot.QuickReplyButton{}
 
 	for index := startIndex; index < endIndex; index++ {
 		val := data[index]
-		var gameInfo string
-		homeScore := val.Boxscore.HomeScore
-		awayScore := val.Boxscore.AwayScore
 		homeTeamName := val.HomeTeamName
 		awayTeamName := val.AwayTeamName
-		status := val.Boxscore.Status
-
-		btnName1 := fmt.Sprintf("%s 數據統計", homeTeamName)
-		btnName2 := fmt.Sprintf("%s 數據統計", awayTeamName)
-		btnName3 := "更新比分 - "
-
-		btnData1 := "player@home@" + val.GameID
-		btnData2 := "player@away@" + val.GameID
-		btnData3 := "score@update@" + val.GameID
-
-		var bt3 linebot.TemplateAction
-		switch status {
-		case "s1": // 1: 未開賽
-			btnName3 += "未開賽"
-			gameInfo = fmt.Sprintf("未開賽 | %s ", val.GameTime)
-			bt3 = linebot.NewPostbackAction(btnName3, btnData3, "", "")
-		case "s2": // 2: 比賽中
-			btnName3 += "進行中"
-			gameInfo = fmt.Sprintf(" %3d - %3d | %s %s", homeScore, awayScore, val.Boxscore.StatusDesc, val.Boxscore.PeriodClock)
-			bt3 = linebot.NewPostbackAction(btnName3, btnData3, "", "")
-		case "s3": // 3: 結束
-			gameInfo = fmt.Sprintf(" %3d - %3d | %s %s", homeScore, awayScore, val.Boxscore.StatusDesc, val.Boxscore.PeriodClock)
-			urlInfo := fmt.Sprintf("#%d %s vs %s Highlights:\n %s", index+1, homeTeamName, awayTeamName, val.HighlightsURL)
-			bt3 = linebot.NewPostbackAction("觀看 Highlights", "echo@msg@"+urlInfo, "", "")
-		}
+		gameInfo := gameStatusText(val, locale, opt.zone)
+
+		btnName1 := Tf(locale, "%s 數據統計", homeTeamName)
+		btnName2 := Tf(locale, "%s 數據統計", awayTeamName)
+
+		btnData1 := PostbackData{Action: PostbackPlayer, GameID: val.GameID, Team: "home"}.Encode()
+		btnData2 := PostbackData{Action: PostbackPlayer, GameID: val.GameID, Team: "away"}.Encode()
+		menuData := PostbackData{Action: PostbackGameMenu, GameID: val.GameID}.Encode()
+
 		teamMessage := fmt.Sprintf("#%d %s vs %s\n      %s", index+1, homeTeamName, awayTeamName, gameInfo)
 		message += teamMessage + "\n"
 
 		// template
 		teamVS := fmt.Sprintf("#%d %s vs %s", index+1, homeTeamName, awayTeamName)
 
+		// the column text is limited to 60 characters
+		columnText := gameInfo
+		pointLeaders := app.carouselLeadersText(val, locale, 60-utf8.RuneCountInString(gameInfo+"\n"))
+		if pointLeaders != "" && utf8.RuneCountInString(gameInfo+"\n"+pointLeaders) <= 60 {
+			columnText += "\n" + pointLeaders
+		}
 		column := linebot.NewCarouselColumn(
-			app.nbaImgURL, teamVS, gameInfo,
+			app.nbaImgURL, teamVS, columnText,
 			linebot.NewPostbackAction(btnName1, btnData1, "", ""),
 			linebot.NewPostbackAction(btnName2, btnData2, "", ""),
-			bt3,
+			gameAction(val, locale),
 		)
 		columns = append(columns, column)
+
+		// a column has room for three buttons, the other views of the game
+		// are in its menu
+		menuLabel := T(locale, GameMenuStr)
+		if endIndex-startIndex > 1 {
+			menuLabel = fmt.Sprintf("#%d %s", index+1, menuLabel)
+		}
+		menuItems = append(menuItems, linebot.NewQuickReplyButton("", linebot.NewPostbackAction(menuLabel, menuData, "", menuLabel)))
 	}
 
 	template := linebot.NewCarouselTemplate(columns...)
 
-	return linebot.NewTemplateMessage(message, template)
+	return linebot.NewTemplateMessage(message, template).WithQuickReplies(linebot.NewQuickReplyItems(menuItems...))
 }
 
-var PlayerInfoColumn = []string{"a4", "位置", "上場時間", "得分", "籃板", "助攻"}
-
-func (app *NBABotClient) ParsePlayInfoToImgMessage(c *gin.Context, pInfo *GamePlayerInfo, teamType string) {
-	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, DATE_TIME_LAYOUT)
-
-	homeTeamName := pInfo.Payload.HomeTeam.Profile.Name
-	awayTeamName := pInfo.Payload.AwayTeam.Profile.Name
-	title += fmt.Sprintf("  %s VS %s", homeTeamName, awayTeamName)
-
-	awayMsgArr := playInfoToMsgArr(pInfo, "away")
-	awayOpt := &TextToImageOpt{
-		SubTitle: "客 - " + awayTeamName,
-		TextData: awayMsgArr,
-	}
-
-	if len(awayMsgArr) < 2 {
-		awayOpt.SubTitle = "未開賽"
-		awayOpt.TextData = [][]string{}
-	}
-	homeMsgArr := playInfoToMsgArr(pInfo, "home")
-	homeOpt := &TextToImageOpt{
-		Title:    title,
-		SubTitle: "主 - " + homeTeamName,
-		TextData: homeMsgArr,
-	}
-	if len(homeMsgArr) < 2 {
-		homeOpt.SubTitle = "未開賽"
-		homeOpt.TextData = [][]string{}
+// gameAction return the button of a game used the most: refresh the score
+// until the game is over, then watch its highlights
+func gameAction(val *GameScoreInfo, locale string) linebot.TemplateAction {
+	switch val.Boxscore.Status {
+	case GameStatusScheduled:
+		scoreData := PostbackData{Action: PostbackScore, GameID: val.GameID}.Encode()
+		return linebot.NewPostbackAction(T(locale, "更新比分 - 未開賽"), scoreData, "", "")
+	case GameStatusFinal:
+		highlights := PostbackData{Action: PostbackHighlights, GameID: val.GameID}.Encode()
+		return linebot.NewPostbackAction(T(locale, "觀看 Highlights"), highlights, "", "")
+	default:
+		scoreData := PostbackData{Action: PostbackScore, GameID: val.GameID}.Encode()
+		return linebot.NewPostbackAction(T(locale, "更新比分 - 進行中"), scoreData, "", "")
 	}
-
-	convertTextArrToTableImage(c, []*TextToImageOpt{
-		homeOpt, awayOpt,
-	}, title)
 }
 
-func playInfoToMsgArr(pInfo *GamePlayerInfo, teamType string) [][]string {
-	messageArr := [][]string{}
-	messageArr = append(messageArr, PlayerInfoColumn)
-	gamePlayers := []GamePlayers{}
-	if teamType == "away" {
-		gamePlayers = pInfo.Payload.AwayTeam.GamePlayers
-	} else {
-		gamePlayers = pInfo.Payload.HomeTeam.GamePlayers
+// gameStatusText return the score and clock of a game, or its start time in
+// zone
+func gameStatusText(val *GameScoreInfo, locale string, zone *time.Location) string {
+	if val.Boxscore.Status == GameStatusScheduled {
+		return Tf(locale, "未開賽 | %s ", val.gameTime(locale, zone))
 	}
+	return fmt.Sprintf(" %3d - %3d | %s %s", val.Boxscore.HomeScore, val.Boxscore.AwayScore, val.Boxscore.StatusDesc, val.Boxscore.PeriodClock)
+}
 
-	for _, player := range gamePlayers {
-		if player.StatTotal.Mins == 0 {
-			continue
-		}
-		mArr := []string{}
-		name := fmt.Sprintf("%s-%s", player.Profile.FirstName, player.Profile.LastName)
-		position := player.Profile.Position
-		upTime := fmt.Sprintf("%02d:%02d", player.StatTotal.Mins, player.StatTotal.Secs)
-		points := strconv.Itoa(player.StatTotal.Points)
-		rebs := strconv.Itoa(player.StatTotal.Rebs)
-		assists := strconv.Itoa(player.StatTotal.Assists)
-		mArr = append(mArr, name, position, upTime, points, rebs, assists)
-		messageArr = append(messageArr, mArr)
+// ParseGameMenuToMessage build the menu of the views of one game not on its
+// carousel column, its alt text is the line score and the leaders
+func (app *NBABotClient) ParseGameMenuToMessage(pInfo *GamePlayerInfo, locale string, zone *time.Location) linebot.SendingMessage {
+	val := parseGamePlayerInfoToGameScoreInfo(pInfo)[0]
+	actions := []linebot.TemplateAction{}
+	if val.Boxscore.Status != GameStatusScheduled {
+		lineScore := PostbackData{Action: PostbackLineScore, GameID: val.GameID}.Encode()
+		compare := PostbackData{Action: PostbackTeamCompare, GameID: val.GameID}.Encode()
+		actions = append(actions,
+			linebot.NewPostbackAction(T(locale, LineScoreStr), lineScore, "", ""),
+			linebot.NewPostbackAction(T(locale, TeamCompareStr), compare, "", ""),
+		)
 	}
-
-	return messageArr
+	leaders := PostbackData{Action: PostbackGameLeaders, GameID: val.GameID}.Encode()
+	actions = append(actions, linebot.NewPostbackAction(T(locale, GameLeadersStr), leaders, "", ""))
+	teamVS := fmt.Sprintf("%s vs %s", val.HomeTeamName, val.AwayTeamName)
+	buttons := linebot.NewButtonsTemplate(app.nbaImgURL, teamVS, gameStatusText(val, locale, zone), actions...)
+	return linebot.NewTemplateMessage(teamVS+"\n"+lineScoreText(pInfo, locale)+"\n"+gameLeadersText(pInfo, locale), buttons)
 }
 
-func (app *NBABotClient) ParsePlayInfoToDetailImgMessage(c *gin.Context, pInfo *GamePlayerInfo, teamType string) {
-	title := UtcMillis2TimeString(pInfo.Payload.GameProfile.UtcMillis, DATE_TIME_LAYOUT)
+// ParsePlayInfoToDetailImgMessage draw the box score of a team with the
+// default columns of PlayerInfoDetailMapColumn followed by the optional
+// columns in cols, labelled in locale
+func (app *NBABotClient) ParsePlayInfoToDetailImgMessage(c *gin.Context, pInfo *GamePlayerInfo, teamType string, cols []StaticsColumn, locale string, zone *time.Location) {
+	title := boxscoreTitle(pInfo, locale, zone)
+	live := pInfo.Payload.Boxscore.Status == GameStatusLive
+	labels := boxscoreLabels(locale)
 
 	homeTeamName := pInfo.Payload.HomeTeam.Profile.Name
 	awayTeamName := pInfo.Payload.AwayTeam.Profile.Name
-	title += fmt.Sprintf("  %s VS %s", homeTeamName, awayTeamName)
-
-	infoOpt := &TextToImageOpt{}
-	if teamType == "away" {
-		awayMsgArr := playInfoToDetailMsgArr(pInfo, "away")
-		infoOpt.SubTitle = awayTeamName
-		infoOpt.TextData = awayMsgArr
-	} else {
-		homeMsgArr := playInfoToDetailMsgArr(pInfo, "home")
-		infoOpt.SubTitle = homeTeamName
-		infoOpt.TextData = homeMsgArr
-	}
-	if len(infoOpt.TextData) < 2 {
-		infoOpt.SubTitle = "未開賽"
-		infoOpt.TextData = [][]string{}
-	}
-
-	convertTextArrToTableImage(c, []*TextToImageOpt{
-		infoOpt,
-	}, title)
-}
 
-// ToDo: 暫無 ＢＡ
-type StaticsColumn struct {
-	CName string
-	EName string
-}
-
-var PlayerInfoDetailMapColumn = []StaticsColumn{
-	{CName: "a5", EName: "PLAYERS"},
-	{CName: "a6", EName: "POS"},
-	{CName: "上場時間", EName: "MIN"},
-	{CName: "投籃命中-投籃出手", EName: "FGM-A"},
-	{CName: "三分球命中數-三分球出手數", EName: "3PM-A"},
-	{CName: "罰球命中-罰球次數", EName: "FTM-A"},
-	{CName: "+/-", EName: "+/-"},
-	{CName: "進攻籃板", EName: "OR"},
-	{CName: "防守籃板", EName: "DR"},
-	{CName: "籃板", EName: "TR"},
-	{CName: "助攻", EName: "AS"},
-	{CName: "犯規", EName: "PF"},
-	{CName: "抄截", EName: "ST"},
-	{CName: "失誤", EName: "TO"},
-	{CName: "阻攻", EName: "BS"},
-	{CName: "得分", EName: "PTS"},
-	{CName: "EFF", EName: "EFF"},
-}
-
-func playInfoToDetailMsgArr(pInfo *GamePlayerInfo, teamType string) [][]string {
-	messageArr := [][]string{}
-	columns := []string{}
+	columns := []StaticsColumn{}
 	for _, col := range PlayerInfoDetailMapColumn {
-		columns = append(columns, col.EName)
-	}
-	messageArr = append(messageArr, columns)
-	gamePlayers := []GamePlayers{}
-	if teamType == "away" {
-		gamePlayers = pInfo.Payload.AwayTeam.GamePlayers
-	} else {
-		gamePlayers = pInfo.Payload.HomeTeam.GamePlayers
-	}
-
-	for _, player := range gamePlayers {
-		if player.StatTotal.Mins == 0 {
-			continue
+		if !col.Optional {
+			columns = append(columns, col)
 		}
-		mArr := []string{}
-		name := fmt.Sprintf("%s. %s", player.Profile.FirstInitial, player.Profile.LastName)
-		position := player.Profile.Position
-		upTime := fmt.Sprintf("%02d:%02d", player.StatTotal.Mins, player.StatTotal.Secs)
-		fgmFga := fmt.Sprintf("%d-%d", player.StatTotal.Fgm, player.StatTotal.Fga)
-		tpmtpa := fmt.Sprintf("%d-%d", player.StatTotal.Tpm, player.StatTotal.Tpa)
-		ftmfta := fmt.Sprintf("%d-%d", player.StatTotal.Ftm, player.StatTotal.Fta)
-		plusMinus := player.Boxscore.PlusMinus
-		offRebs := player.StatTotal.OffRebs
-		defRebs := player.StatTotal.DefRebs
-		totalRebs := offRebs + defRebs
-		assists := player.StatTotal.Assists
-		fouls := player.StatTotal.Fouls
-		turnovers := player.StatTotal.Turnovers
-		blocks := player.StatTotal.Blocks
-		points := player.StatTotal.Points
-		steals := player.StatTotal.Steals
-
-		// EFF: (PTS + TRB + AST + STL + BLK) - (FGA-FGM) - (FTA-FTM) - TO
-		eff := (points + totalRebs + assists + steals + blocks) - (player.StatTotal.Fga - player.StatTotal.Fgm) - (player.StatTotal.Fta - player.StatTotal.Ftm) - turnovers
-
-		mArr = append(mArr, name, position, upTime, fgmFga, tpmtpa, ftmfta, plusMinus, strconv.Itoa(offRebs), strconv.Itoa(defRebs), strconv.Itoa(totalRebs), strconv.Itoa(assists), strconv.Itoa(fouls), strconv.Itoa(steals), strconv.Itoa(turnovers), strconv.Itoa(blocks), strconv.Itoa(points), strconv.Itoa(eff))
-		messageArr = append(messageArr, mArr)
 	}
-
-	return messageArr
-}
-
-var StandingInfoColumn = []string{"", "a7", "勝負", "勝差"}
-
-func (app *NBABotClient) ParseConferenceStandingToImgMessage(c *gin.Context, data *ConferenceStanding, conference string) {
-	messageArr := [][]string{}
-	messageArr = append(messageArr, StandingInfoColumn)
-	title := ""
-	for _, group := range data.Payload.StandingGroups {
-		if strings.ToLower(group.Conference) == strings.ToLower(conference) {
-			teams := group.Teams
-			sort.Slice(teams, func(i, j int) bool {
-				return group.Teams[j].Standings.ConfRank > group.Teams[i].Standings.ConfRank
-			})
-			for _, team := range teams {
-				mArr := []string{}
-				rank := fmt.Sprintf("%02d", team.Standings.ConfRank)
-				teamName := team.Profile.Name
-				winLose := fmt.Sprintf("%2d - %2d", team.Standings.Wins, team.Standings.Losses)
-				confGamesBehind := fmt.Sprintf("%.1f", team.Standings.ConfGamesBehind)
-				mArr = append(mArr, rank, teamName, winLose, confGamesBehind)
-				messageArr = append(messageArr, mArr)
-			}
-		}
+	columns = append(columns, cols...)
+	header := []string{}
+	for _, col := range columns {
+		header = append(header, col.Header(locale))
 	}
-	opt := &TextToImageOpt{
-		TextData: messageArr,
+	row := func(player GamePlayers) []string {
+		cells := []string{}
+		for _, col := range columns {
+			cells = append(cells, col.Value(player))
+		}
+		return cells
 	}
-	if conference == "a8" {
-		title = "東區戰績"
+	var opts []*TextToImageOpt
+	if teamType == "away" {
+		opts = boxscoreToImageOpts(awayTeamName, header, pInfo.Payload.AwayTeam.GamePlayers, row, labels, live)
 	} else {
-		title = "西區戰績"
+		opts = boxscoreToImageOpts(homeTeamName, header, pInfo.Payload.HomeTeam.GamePlayers, row, labels, live)
 	}
 
-	convertTextArrToTableImage(c, []*TextToImageOpt{opt}, title)
+	convertTextArrToTableImage(c, append(opts, boxscoreFooter(pInfo, labels, zone)...), title)
 }
 
-func (aoo *NBABotClient) ParsePlayoffsToImgMessage(c *gin.Context, data *BracketInfo) {
-	title := "季後賽對戰表"
+func (aoo *NBABotClient) ParsePlayoffsToImgMessage(c *gin.Context, data *BracketInfo, locale string) {
+	title := T(locale, "季後賽對戰表")
 	teamFormat := "%s vs %s"
 
 	opts := []*TextToImageOpt{}
@@ -731,9 +446,9 @@ func (aoo *NBABotClient) ParsePlayoffsToImgMessage(c *gin.Context, data *Bracket
 			continue
 		}
 		if groupName == "Eastern" {
-			gName = "東區"
+			gName = T(locale, "東區")
 		} else if groupName == "Western" {
-			gName = "西區"
+			gName = T(locale, "西區")
 		}
 		for _, round := range group.Rounds {
 			opt := TextToImageOpt{}
@@ -764,6 +479,23 @@ type TextToImageOpt struct {
 	Title    string
 	SubTitle string
 	TextData [][]string
+	// Highlight mark the cells of TextData drawn in highlightColor
+	Highlight [][]bool
+}
+
+var highlightColor = image.NewUniform(color.RGBA{R: 0xff, G: 0xc8, B: 0x00, A: 0xff})
+
+func (opt *TextToImageOpt) highlighted(row, col int) bool {
+	return row < len(opt.Highlight) && col < len(opt.Highlight[row]) && opt.Highlight[row][col]
+}
+
+// loadFont read the font of the images
+func loadFont() (*truetype.Font, error) {
+	fontBytes, err := ioutil.ReadFile(_fontPath)
+	if err != nil {
+		return nil, err
+	}
+	return truetype.Parse(fontBytes)
 }
 
 func convertTextArrToTableImage(c *gin.Context, opts []*TextToImageOpt, title string) {
@@ -790,14 +522,7 @@ func convertTextArrToTableImage(c *gin.Context, opts []*TextToImageOpt, title st
 		return
 	}
 
-	// Read the font data.
-	fontfile := _fontPath
-	fontBytes, err := ioutil.ReadFile(fontfile)
-	if err != nil {
-		log.Println(err)
-		return
-	}
-	f, err := truetype.Parse(fontBytes)
+	f, err := loadFont()
 	if err != nil {
 		log.Println(err)
 		return
@@ -846,7 +571,7 @@ func convertTextArrToTableImage(c *gin.Context, opts []*TextToImageOpt, title st
 
 				yAxis = yAxisLast
 				xAxis += preTextLen*11 + 20
-				for _, row := range textData {
+				for rowIndex, row := range textData {
 					yAxis += dy
 					text := row[index]
 					textLen := getRealTextLength(text)
@@ -854,7 +579,11 @@ func convertTextArrToTableImage(c *gin.Context, opts []*TextToImageOpt, title st
 						maxTextlen = textLen
 					}
 					d.Dot = fixed.P(xAxis, yAxis)
+					if opt.highlighted(rowIndex, index) {
+						d.Src = highlightColor
+					}
 					d.DrawString(text)
+					d.Src = fg
 				}
 				preTextLen = maxTextlen
 			}
@@ -875,48 +604,67 @@ func convertTextArrToTableImage(c *gin.Context, opts []*TextToImageOpt, title st
 	}
 }
 
+// getGameView serve the image of the view named by the type param, or the
+// box score of the home or away team
+func (app *NBABotClient) getGameView(c *gin.Context) {
+	switch c.Param("type") {
+	case "linescore":
+		app.getLineScore(c)
+	case "compare":
+		app.getTeamCompare(c)
+	case "leaders":
+		app.getGameLeaders(c)
+	default:
+		app.getGamePlayInfo(c)
+	}
+}
+
 func (app *NBABotClient) getGamePlayInfo(c *gin.Context) {
 	gameID := c.Param("gameid")
 	teamType := c.Param("type")
 
-	pInfo, err := GetNBAGamePlayerByGameID(gameID, "zh_TW")
+	locale := requestLocale(c)
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(gameID, locale)
 	if err != nil {
 		log.Printf("GetNBAGamePlayerByGameID err: %v", err)
+		c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
 		return
 	}
-	app.CounterIncs("比賽數據圖片")
-	app.ParsePlayInfoToImgMessage(c, pInfo, teamType)
-}
-
-func (app *NBABotClient) getGamePlayInfoEN(c *gin.Context) {
-	gameID := c.Param("gameid")
-	teamType := c.Param("type")
-
-	pInfo, err := GetNBAGamePlayerByGameID(gameID, "en")
+	cols, err := optionalColumns(c.Query("cols"))
 	if err != nil {
-		log.Printf("GetNBAGamePlayerByGameID err: %v", err)
+		c.String(http.StatusBadRequest, err.Error())
 		return
 	}
 	app.CounterIncs("比賽數據圖片")
-	app.ParsePlayInfoToDetailImgMessage(c, pInfo, teamType)
+	app.ParsePlayInfoToDetailImgMessage(c, pInfo, teamType, cols, locale, requestZone(c))
 }
 
 func (app *NBABotClient) getStandingInfo(c *gin.Context) {
 	conference := c.Param("conference")
-	if conference == "playoffs" {
-		data, err := GetNBAPlayoffs()
+	locale := requestLocale(c)
+	if conference == "picture" {
+		app.getPlayoffPicture(c)
+	} else if conference == "playoffs" {
+		data, err := app.source.GetNBAPlayoffs(locale)
 		if err != nil {
 			log.Printf("GetNBAPlayoffs err: %v", err)
+			c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+			return
+		}
+		if c.Query("style") == BracketStyle {
+			app.ParsePlayoffsToBracketImage(c, data, locale)
+		} else {
+			app.ParsePlayoffsToImgMessage(c, data, locale)
 		}
-		app.ParsePlayoffsToImgMessage(c, data)
 		app.CounterIncs("季後賽圖片")
 	} else {
-		data, err := GetNBAConferenceStanding()
+		data, err := app.source.GetNBAConferenceStanding(locale)
 		if err != nil {
 			log.Printf("getStandingInfo err: %v", err)
+			c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+			return
 		}
-		app.ParseConferenceStandingToImgMessage(c, data, conference)
-		app.CounterIncs("戰績圖片")
+		app.getStanding(c, data, conference, locale)
 	}
 }
 
@@ -981,39 +729,60 @@ func parseGameInfoToGameScoreInfo(data *GameInfo) []*GameScoreInfo {
 			}
 		}
 		gameInfoArr = append(gameInfoArr, &GameScoreInfo{
-			Boxscore:      game.Boxscore,
-			GameID:        game.Profile.GameID,
-			HomeTeamName:  game.HomeTeam.Profile.Name,
-			AwayTeamName:  game.AwayTeam.Profile.Name,
-			GameTime:      UtcMillis2TimeString(game.Profile.UtcMillis, DATE_TIME_LAYOUT),
-			HighlightsURL: highlightsURL,
+			Boxscore:        game.Boxscore,
+			GameID:          game.Profile.GameID,
+			HomeTeamName:    game.HomeTeam.Profile.Name,
+			AwayTeamName:    game.AwayTeam.Profile.Name,
+			UtcMillis:       game.Profile.UtcMillis,
+			HighlightsURL:   highlightsURL,
+			HomePointLeader: game.HomeTeam.PointGameLeader,
+			AwayPointLeader: game.AwayTeam.PointGameLeader,
 		})
 	}
 	return gameInfoArr
 }
 
 func parseGamePlayerInfoToGameScoreInfo(data *GamePlayerInfo) []*GameScoreInfo {
+	highlightsURL := ""
+	for _, val := range data.Payload.Urls {
+		if val.Type == "Highlights" {
+			highlightsURL = val.Value
+			break
+		}
+	}
 	game := GameScoreInfo{
-		Boxscore:     data.Payload.Boxscore,
-		GameID:       data.Payload.GameProfile.GameID,
-		HomeTeamName: data.Payload.HomeTeam.Profile.Name,
-		AwayTeamName: data.Payload.AwayTeam.Profile.Name,
-		GameTime:     UtcMillis2TimeString(data.Payload.GameProfile.UtcMillis, DATE_TIME_LAYOUT),
+		Boxscore:        data.Payload.Boxscore,
+		GameID:          data.Payload.GameProfile.GameID,
+		HomeTeamName:    data.Payload.HomeTeam.Profile.Name,
+		AwayTeamName:    data.Payload.AwayTeam.Profile.Name,
+		UtcMillis:       data.Payload.GameProfile.UtcMillis,
+		HighlightsURL:   highlightsURL,
+		HomePointLeader: data.Payload.HomeTeam.PointGameLeader,
+		AwayPointLeader: data.Payload.AwayTeam.PointGameLeader,
 	}
 	return []*GameScoreInfo{&game}
 }
 
 func (app *NBABotClient) getGameColumnInfo(c *gin.Context) {
+	locale := requestLocale(c)
 	data := [][]string{}
+	optional := [][]string{}
 	for _, col := range PlayerInfoDetailMapColumn {
-		row := []string{col.EName, col.CName}
-		data = append(data, row)
+		if col.Optional {
+			optional = append(optional, []string{col.EName, T(locale, col.CName), col.Key})
+			continue
+		}
+		data = append(data, []string{col.EName, T(locale, col.CName)})
 	}
 	convertTextArrToTableImage(c, []*TextToImageOpt{
 		{
 			TextData: data,
 		},
-	}, "數據統計說明")
+		{
+			SubTitle: T(locale, "進階數據 ?cols="),
+			TextData: optional,
+		},
+	}, T(locale, "數據統計說明"))
 }
 
 func (app *NBABotClient) ListMessages(c *gin.Context) {
@@ -20,6 +20,38 @@ func Migrate() {
 				return nil
 			},
 		},
+		{
+			ID: "202610181200",
+			Migrate: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&Subscription{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTable("subscriptions").Error
+			},
+		},
+		{
+			ID: "202610181300",
+			Migrate: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&ChatSettings{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.DropTable("chat_settings").Error
+			},
+		},
+		{
+			ID: "202610181400",
+			Migrate: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&ChatSettings{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, column := range []string{"timezone", "favorite_team_id", "favorite_team_name", "mute_start", "mute_halftime", "mute_final", "text_only", "mention_only"} {
+					if err := tx.Model(&ChatSettings{}).DropColumn(column).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
 	})
 
 	// TODO: add custom type
@@ -28,6 +60,12 @@ func Migrate() {
 		if err := repo.AutoMigrate(&Message{}).Error; err != nil {
 			return err
 		}
+		if err := repo.AutoMigrate(&Subscription{}).Error; err != nil {
+			return err
+		}
+		if err := repo.AutoMigrate(&ChatSettings{}).Error; err != nil {
+			return err
+		}
 
 		return nil
 	})
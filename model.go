@@ -8,3 +8,34 @@ type Message struct {
 	MessageID string `json:"messageID,omitempty" gorm:"type:varchar(255);not null"`
 	Message   string `json:"message,omitempty" gorm:"type:text;not null;default:''"`
 }
+
+// Subscription is a chat following a team, ChatID is the group, room or
+// user ID of the LINE event source
+type Subscription struct {
+	ID       uint   `json:"id" gorm:"primary_key"`
+	ChatID   string `json:"chatId" gorm:"type:varchar(255);not null;unique_index:idx_subscription_chat_team"`
+	TeamID   string `json:"teamId" gorm:"type:varchar(255);not null;unique_index:idx_subscription_chat_team;index"`
+	TeamName string `json:"teamName" gorm:"type:varchar(255);not null;default:''"`
+}
+
+// ChatSettings are the preferences of a chat, ChatID is the group, room or
+// user ID of the LINE event source
+type ChatSettings struct {
+	ID     uint   `json:"id" gorm:"primary_key"`
+	ChatID string `json:"chatId" gorm:"type:varchar(255);not null;unique_index"`
+	// Locale of the replies, DefaultLocale when empty
+	Locale string `json:"locale" gorm:"type:varchar(16);not null;default:''"`
+	// Timezone is an IANA zone name, the default zone when empty
+	Timezone         string `json:"timezone" gorm:"type:varchar(64);not null;default:''"`
+	FavoriteTeamID   string `json:"favoriteTeamId" gorm:"type:varchar(255);not null;default:''"`
+	FavoriteTeamName string `json:"favoriteTeamName" gorm:"type:varchar(255);not null;default:''"`
+	// MuteStart, MuteHalftime and MuteFinal turn off the notifications of
+	// the followed teams
+	MuteStart    bool `json:"muteStart" gorm:"not null;default:false"`
+	MuteHalftime bool `json:"muteHalftime" gorm:"not null;default:false"`
+	MuteFinal    bool `json:"muteFinal" gorm:"not null;default:false"`
+	// TextOnly replace images and templates with plain text
+	TextOnly bool `json:"textOnly" gorm:"not null;default:false"`
+	// MentionOnly only answer the texts mentioning the bot in groups and rooms
+	MentionOnly bool `json:"mentionOnly" gorm:"not null;default:false"`
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	PlayoffPictureStr = "季後賽形勢"
+	CmdPlayoffPicture = _cmd_prefix + PlayoffPictureStr
+	PlayoffSeedsStr   = "季後賽席位"
+	PlayInStr         = "附加賽"
+	BubbleStr         = "爭取附加賽"
+	EliminatedStr     = "已淘汰"
+	SeedLineStr       = "分界勝差"
+	PlayInGameFormat  = "%d %s vs %d %s"
+)
+
+const (
+	// playoffSeeds are the seeds going straight to the playoffs
+	playoffSeeds = 6
+	// playInSeeds are the last seed of the play-in tournament
+	playInSeeds = 10
+	// clinchedEliminated is the Clinched code of eliminated teams
+	clinchedEliminated = "o"
+)
+
+// gamesBehind return the games team is behind other, negative when ahead
+func gamesBehind(team StandingTeam, other StandingTeam) float64 {
+	return float64((other.Standings.Wins-team.Standings.Wins)+(team.Standings.Losses-other.Standings.Losses)) / 2
+}
+
+// playoffPicture is the playoff picture of a conference
+type playoffPicture struct {
+	Conference string
+	Seeds      []standingRow
+	PlayIn     []standingRow
+	Bubble     []standingRow
+	Eliminated []standingRow
+}
+
+// newPlayoffPicture split the conference standing into the playoff seeds,
+// the play-in teams, the teams still chasing the play-in and the eliminated
+// teams
+func newPlayoffPicture(conference string, rows []standingRow) *playoffPicture {
+	p := &playoffPicture{Conference: conference}
+	for _, row := range rows {
+		switch {
+		case row.Rank <= playoffSeeds:
+			p.Seeds = append(p.Seeds, row)
+		case row.Rank <= playInSeeds:
+			p.PlayIn = append(p.PlayIn, row)
+		case row.Team.Standings.Clinched == clinchedEliminated:
+			p.Eliminated = append(p.Eliminated, row)
+		default:
+			p.Bubble = append(p.Bubble, row)
+		}
+	}
+	return p
+}
+
+// seedLine return the games row is ahead of the line it must stay above, or
+// behind the line it must reach: playoff seeds are compared to the first
+// play-in team, play-in teams to the last playoff seed and the others to the
+// last play-in team
+func (p *playoffPicture) seedLine(row standingRow) string {
+	var line []standingRow
+	var index int
+	switch {
+	case row.Rank <= playoffSeeds:
+		line, index = p.PlayIn, 0
+	case row.Rank <= playInSeeds:
+		line, index = p.Seeds, len(p.Seeds)-1
+	default:
+		line, index = p.PlayIn, len(p.PlayIn)-1
+	}
+	if index < 0 || index >= len(line) {
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f", gamesBehind(line[index].Team, row.Team))
+}
+
+// tableRows return the rows of a table image, starting with the header row
+// translated to locale
+func (p *playoffPicture) tableRows(rows []standingRow, locale string) [][]string {
+	data := [][]string{{"", T(locale, "a7"), "", T(locale, "勝負"), T(locale, SeedLineStr)}}
+	for _, row := range rows {
+		data = append(data, []string{
+			fmt.Sprintf("%02d", row.Rank),
+			row.Team.Profile.Name,
+			row.Team.Standings.Clinched,
+			winLoss(row.Team.Standings.Wins, row.Team.Standings.Losses),
+			p.seedLine(row),
+		})
+	}
+	return data
+}
+
+// playInGames return the 7 vs 8 and 9 vs 10 games of the play-in
+func (p *playoffPicture) playInGames() [][]string {
+	games := [][]string{}
+	for i := 0; i+1 < len(p.PlayIn); i += 2 {
+		high, low := p.PlayIn[i], p.PlayIn[i+1]
+		games = append(games, []string{
+			fmt.Sprintf(PlayInGameFormat, high.Rank, high.Team.Profile.Name, low.Rank, low.Team.Profile.Name),
+		})
+	}
+	return games
+}
+
+func (p *playoffPicture) imageOpts(name string, locale string) []*TextToImageOpt {
+	opts := []*TextToImageOpt{
+		{SubTitle: name + " " + T(locale, PlayoffSeedsStr), TextData: p.tableRows(p.Seeds, locale)},
+	}
+	if len(p.PlayIn) > 0 {
+		opts = append(opts,
+			&TextToImageOpt{SubTitle: name + " " + T(locale, PlayInStr), TextData: p.tableRows(p.PlayIn, locale)},
+			&TextToImageOpt{TextData: p.playInGames()},
+		)
+	}
+	if len(p.Bubble) > 0 {
+		opts = append(opts, &TextToImageOpt{SubTitle: name + " " + T(locale, BubbleStr), TextData: p.tableRows(p.Bubble, locale)})
+	}
+	if len(p.Eliminated) > 0 {
+		opts = append(opts, &TextToImageOpt{SubTitle: name + " " + T(locale, EliminatedStr), TextData: p.tableRows(p.Eliminated, locale)})
+	}
+	return opts
+}
+
+func (app *NBABotClient) ParsePlayoffPictureToImgMessage(c *gin.Context, data *ConferenceStanding, locale string) {
+	opts := []*TextToImageOpt{}
+	for _, group := range data.Payload.StandingGroups {
+		picture := newPlayoffPicture(group.Conference, conferenceStandingRows(data, group.Conference))
+		opts = append(opts, picture.imageOpts(group.DisplayConference, locale)...)
+	}
+	opts = append(opts, &TextToImageOpt{SubTitle: T(locale, ClinchedLegendStr)})
+	convertTextArrToTableImage(c, opts, T(locale, PlayoffPictureStr))
+}
+
+func (app *NBABotClient) getPlayoffPicture(c *gin.Context) {
+	locale := requestLocale(c)
+	data, err := app.source.GetNBAConferenceStanding(locale)
+	if err != nil {
+		log.Printf("getPlayoffPicture err: %v", err)
+		c.String(sourceErrorStatus(err), sourceErrorText(err, locale))
+		return
+	}
+	app.ParsePlayoffPictureToImgMessage(c, data, locale)
+	app.CounterIncs("季後賽形勢圖片")
+}
@@ -14,7 +14,8 @@ func LeftPad2Len(s string, padStr string, overallLen int) string {
 	return retStr[(len(retStr) - overallLen):]
 }
 
-func UtcMillis2TimeString(utcMillisStr string, timeFormat string) string {
+// UtcMillis2TimeString format utcMillisStr in zone
+func UtcMillis2TimeString(utcMillisStr string, timeFormat string, zone *time.Location) string {
 	utcMillis, err := strconv.ParseInt(utcMillisStr, 10, 64)
 	if err != nil {
 		log.Printf("parse time error: %v", err)
@@ -22,7 +23,7 @@ func UtcMillis2TimeString(utcMillisStr string, timeFormat string) string {
 	}
 	utcTimestamp := utcMillis / 1000
 	gameTime := time.Unix(utcTimestamp, 0)
-	gameTimeStr := gameTime.In(_localZone).Format(timeFormat)
+	gameTimeStr := gameTime.In(zone).Format(timeFormat)
 	return gameTimeStr
 }
 
@@ -30,3 +31,19 @@ func GetLocalTime(t time.Time) (*time.Time, error) {
 	localTime := t.In(_localZone)
 	return &localTime, nil
 }
+
+// isToday tell whether t is on the current day of its zone
+func isToday(t time.Time) bool {
+	y, m, d := t.Date()
+	ty, tm, td := time.Now().In(t.Location()).Date()
+	return y == ty && m == tm && d == td
+}
+
+// millisTime parse a time of unix milliseconds, false if it is empty
+func millisTime(millis string) (time.Time, bool) {
+	ms, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(ms/1000, 0), true
+}
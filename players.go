@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	PlayerSearchStr     = "球員"
+	PlayerUsageStr      = "請輸入球員名字或背號，例如：球員 Curry"
+	PlayerNotFoundStr   = "今日賽事找不到球員：%s"
+	PlayerNotStartedStr = "%s 的比賽尚未開始，開賽時間 %s"
+	PlayerDNPStr        = "未上場"
+	PlayerMoreStr       = "還有 %d 位球員符合，請輸入更完整的名字"
+)
+
+// maxPlayerCards is the number of players replied for one search
+const maxPlayerCards = 3
+
+// playerMatch is a player found in a game snapshot
+type playerMatch struct {
+	player   GamePlayers
+	teamName string
+	game     *GamePlayerInfo
+}
+
+func (app *NBABotClient) cmdPlayer(ctx *CommandContext) (linebot.SendingMessage, error) {
+	query := ctx.Args.Query
+	if query == "" {
+		return linebot.NewTextMessage(T(ctx.Locale, PlayerUsageStr)), nil
+	}
+	matches, err := app.searchPlayers(query, ctx.Locale, ctx.Settings.Zone())
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return linebot.NewTextMessage(Tf(ctx.Locale, PlayerNotFoundStr, query)), nil
+	}
+	cards := []string{}
+	for i, match := range matches {
+		if i == maxPlayerCards {
+			cards = append(cards, Tf(ctx.Locale, PlayerMoreStr, len(matches)-maxPlayerCards))
+			break
+		}
+		cards = append(cards, playerStatCard(match, ctx.Locale, ctx.Settings.Zone()))
+	}
+	return linebot.NewTextMessage(strings.Join(cards, "\n\n")), nil
+}
+
+// searchPlayers find the players of the games of today in zone matching
+// query, fetched in locale. Exact matches on a name or jersey number hide the
+// partial name matches.
+func (app *NBABotClient) searchPlayers(query string, locale string, zone *time.Location) ([]playerMatch, error) {
+	data, err := app.gamesOn(time.Now().In(zone), locale)
+	if err != nil {
+		return nil, err
+	}
+	exact := []playerMatch{}
+	partial := []playerMatch{}
+	// a player plays one game a day, the fixtures repeat the same game
+	seen := map[string]bool{}
+	for _, game := range data.Payload.Date.Games {
+		pInfo, err := app.source.GetNBAGamePlayerByGameID(game.Profile.GameID, locale)
+		if err != nil {
+			log.Printf("searchPlayers GetNBAGamePlayerByGameID %s error: %v", game.Profile.GameID, err)
+			continue
+		}
+		teams := []struct {
+			name    string
+			players []GamePlayers
+		}{
+			{pInfo.Payload.HomeTeam.Profile.Name, pInfo.Payload.HomeTeam.GamePlayers},
+			{pInfo.Payload.AwayTeam.Profile.Name, pInfo.Payload.AwayTeam.GamePlayers},
+		}
+		for _, team := range teams {
+			for _, player := range team.players {
+				if seen[player.Profile.PlayerID] {
+					continue
+				}
+				seen[player.Profile.PlayerID] = true
+				match := playerMatch{player: player, teamName: team.name, game: pInfo}
+				switch matchPlayer(player, query) {
+				case 2:
+					exact = append(exact, match)
+				case 1:
+					partial = append(partial, match)
+				}
+			}
+		}
+	}
+	if len(exact) > 0 {
+		return exact, nil
+	}
+	return partial, nil
+}
+
+// matchPlayer return 2 for an exact match of query, 1 for a partial match of
+// a name and 0 otherwise
+func matchPlayer(player GamePlayers, query string) int {
+	p := player.Profile
+	names := []string{p.DisplayName, p.DisplayNameEn, p.LastNameEn, p.LastName}
+	for _, name := range append(names, p.JerseyNo) {
+		if name != "" && strings.EqualFold(name, query) {
+			return 2
+		}
+	}
+	query = strings.ToLower(query)
+	for _, name := range names {
+		if name != "" && strings.Contains(strings.ToLower(name), query) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// playerStatCard format the game stats of a player, the start time in zone
+func playerStatCard(match playerMatch, locale string, zone *time.Location) string {
+	player := match.player
+	p := player.Profile
+	box := match.game.Payload.Boxscore
+	home := match.game.Payload.HomeTeam.Profile.Name
+	away := match.game.Payload.AwayTeam.Profile.Name
+
+	title := fmt.Sprintf("%s #%s %s", p.DisplayName, p.JerseyNo, match.teamName)
+	if p.Position != "" {
+		title += fmt.Sprintf(" (%s)", p.Position)
+	}
+	if box.Status == GameStatusScheduled {
+		gameTime := UtcMillis2TimeString(match.game.Payload.GameProfile.UtcMillis, T(locale, DATE_TIME_LAYOUT), zone)
+		return title + "\n" + Tf(locale, PlayerNotStartedStr, home+" vs "+away, gameTime)
+	}
+
+	lines := []string{
+		title,
+		fmt.Sprintf("%s %d - %d %s | %s %s", home, box.HomeScore, box.AwayScore, away, box.StatusDesc, box.PeriodClock),
+	}
+	s := player.StatTotal
+	if s.Mins == 0 && s.Secs == 0 {
+		dnp := T(locale, PlayerDNPStr)
+		if player.Boxscore.DnpReason != "" {
+			dnp += " - " + player.Boxscore.DnpReason
+		}
+		return strings.Join(append(lines, dnp), "\n")
+	}
+	lines = append(lines,
+		Tf(locale, "上場時間 %02d:%02d", s.Mins, s.Secs),
+		Tf(locale, "得分 %d | 籃板 %d (進攻 %d 防守 %d) | 助攻 %d", s.Points, s.OffRebs+s.DefRebs, s.OffRebs, s.DefRebs, s.Assists),
+		Tf(locale, "投籃 %s | 三分 %s | 罰球 %s", shootingSplit(s.Fgm, s.Fga), shootingSplit(s.Tpm, s.Tpa), shootingSplit(s.Ftm, s.Fta)),
+		Tf(locale, "抄截 %d | 阻攻 %d | 失誤 %d | 犯規 %d", s.Steals, s.Blocks, s.Turnovers, s.Fouls),
+		fmt.Sprintf("+/- %s | EFF %d", player.Boxscore.PlusMinus, playerEff(player)),
+	)
+	return strings.Join(lines, "\n")
+}
+
+// shootingSplit format made-attempted and the percentage, e.g. "5-10 (50.0%)"
+func shootingSplit(made, attempted int) string {
+	if attempted == 0 {
+		return "0-0"
+	}
+	return fmt.Sprintf("%d-%d (%.1f%%)", made, attempted, float64(made)*100/float64(attempted))
+}
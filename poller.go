@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type PollerConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+const defaultPollInterval = 30 * time.Second
+
+type GameEventType string
+
+const (
+	GameEventStarted     GameEventType = "started"
+	GameEventPeriodEnded GameEventType = "period_ended"
+	GameEventLeadChange  GameEventType = "lead_change"
+	GameEventCloseGame   GameEventType = "close_game"
+	GameEventOvertime    GameEventType = "overtime"
+	GameEventFinal       GameEventType = "final"
+)
+
+const (
+	regulationPeriods = 4
+	// a game is close when the margin is at most closeGameMargin points with
+	// at most closeGameSeconds left in the 4th quarter or an overtime
+	closeGameMargin  = 5
+	closeGameSeconds = 5 * 60
+)
+
+// GameEvent is a change detected between two snapshots of a game
+type GameEvent struct {
+	Type   GameEventType
+	GameID string
+	// Period the event happened in, e.g. the period that ended
+	Period int
+	// Game is the snapshot the event was detected on
+	Game *GamePlayerInfo
+}
+
+func (e GameEvent) String() string {
+	return fmt.Sprintf("%s %s period %d", e.GameID, e.Type, e.Period)
+}
+
+// gameState is what the poller remember of a game between two polls
+type gameState struct {
+	status          string
+	period          int
+	leader          int // 1 home, -1 away, 0 never led
+	endedPeriod     int
+	closeGamePeriod int
+}
+
+// GamePoller follow today's schedule, poll the snapshot of games in progress
+// and publish the changes to its subscribers.
+type GamePoller struct {
+	source   DataSource
+	interval time.Duration
+
+	sync.RWMutex
+	handlers []func(GameEvent)
+	states   map[string]*gameState
+}
+
+func NewGamePoller(source DataSource, config PollerConfig) *GamePoller {
+	interval := config.Interval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+	return &GamePoller{
+		source:   source,
+		interval: interval,
+		states:   map[string]*gameState{},
+	}
+}
+
+// Subscribe register handler for every event. Handlers are called from the
+// poller goroutine and should not block.
+func (p *GamePoller) Subscribe(handler func(GameEvent)) {
+	p.Lock()
+	p.handlers = append(p.handlers, handler)
+	p.Unlock()
+}
+
+// Run poll until quit is closed
+func (p *GamePoller) Run(quit <-chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.poll()
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *GamePoller) poll() {
+	data, err := p.source.GetNBAGameToday(DefaultLocale)
+	if err != nil {
+		log.Printf("poller GetNBAGameToday error: %v", err)
+		return
+	}
+	today := map[string]bool{}
+	for _, game := range data.Payload.Date.Games {
+		gameID := game.Profile.GameID
+		today[gameID] = true
+		prev, seen := p.states[gameID]
+		status := game.Boxscore.Status
+		// only games in progress, or that just left it, need a snapshot
+		if seen && status == prev.status && status != GameStatusLive {
+			continue
+		}
+		if !seen && status != GameStatusLive {
+			p.states[gameID] = &gameState{status: status}
+			continue
+		}
+		pInfo, err := p.source.GetNBAGamePlayerByGameID(gameID, "zh_TW")
+		if err != nil {
+			log.Printf("poller GetNBAGamePlayerByGameID %s error: %v", gameID, err)
+			continue
+		}
+		state, events := diffGame(prev, pInfo)
+		p.states[gameID] = state
+		for _, event := range events {
+			p.publish(event)
+		}
+	}
+	// forget the games of previous days
+	for gameID := range p.states {
+		if !today[gameID] {
+			delete(p.states, gameID)
+		}
+	}
+}
+
+func (p *GamePoller) publish(event GameEvent) {
+	log.Printf("poller event: %s", event)
+	p.RLock()
+	handlers := p.handlers
+	p.RUnlock()
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// diffGame compare the snapshot with the previous state of the game. The
+// first observation of a game only sets the baseline.
+func diffGame(prev *gameState, pInfo *GamePlayerInfo) (*gameState, []GameEvent) {
+	box := pInfo.Payload.Boxscore
+	period, _ := strconv.Atoi(box.Period)
+	cur := &gameState{
+		status: box.Status,
+		period: period,
+		leader: leaderOf(box.HomeScore, box.AwayScore),
+	}
+	if prev == nil {
+		cur.endedPeriod = period - 1
+		if isPeriodOver(box) {
+			cur.endedPeriod = period
+		}
+		return cur, nil
+	}
+	cur.endedPeriod = prev.endedPeriod
+	cur.closeGamePeriod = prev.closeGamePeriod
+	if cur.leader == 0 {
+		cur.leader = prev.leader
+	}
+
+	events := []GameEvent{}
+	newEvent := func(eventType GameEventType, period int) {
+		events = append(events, GameEvent{
+			Type:   eventType,
+			GameID: pInfo.Payload.GameProfile.GameID,
+			Period: period,
+			Game:   pInfo,
+		})
+	}
+
+	if prev.status != GameStatusLive && prev.status != GameStatusFinal && cur.status != prev.status {
+		newEvent(GameEventStarted, 1)
+	}
+	if cur.status == GameStatusFinal {
+		if prev.status != GameStatusFinal {
+			newEvent(GameEventFinal, period)
+		}
+		return cur, events
+	}
+	if cur.status != GameStatusLive {
+		return cur, events
+	}
+
+	// a new period means the previous one ended, even if no poll saw 00:00
+	if period > prev.period && cur.endedPeriod < period-1 {
+		cur.endedPeriod = period - 1
+		newEvent(GameEventPeriodEnded, period-1)
+	}
+	if isPeriodOver(box) && cur.endedPeriod < period {
+		cur.endedPeriod = period
+		newEvent(GameEventPeriodEnded, period)
+	}
+	if period > regulationPeriods && period > prev.period {
+		newEvent(GameEventOvertime, period)
+	}
+	if prev.leader != 0 && cur.leader != prev.leader {
+		newEvent(GameEventLeadChange, period)
+	}
+	if period >= regulationPeriods && cur.closeGamePeriod < period && !isPeriodOver(box) {
+		margin := box.HomeScore - box.AwayScore
+		if margin < 0 {
+			margin = -margin
+		}
+		if left, ok := clockSeconds(box.PeriodClock); ok && left <= closeGameSeconds && margin <= closeGameMargin {
+			cur.closeGamePeriod = period
+			newEvent(GameEventCloseGame, period)
+		}
+	}
+	return cur, events
+}
+
+func leaderOf(homeScore, awayScore int) int {
+	switch {
+	case homeScore > awayScore:
+		return 1
+	case homeScore < awayScore:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func isPeriodOver(box GameBoxscore) bool {
+	left, ok := clockSeconds(box.PeriodClock)
+	return ok && left == 0
+}
+
+// clockSeconds parse a "mm:ss" period clock
+func clockSeconds(clock string) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(clock), ":")
+	if len(parts) != 2 {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	seconds, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	return minutes*60 + int(seconds), true
+}
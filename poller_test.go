@@ -0,0 +1,152 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+const fixtureGameID = "0021700784"
+
+// snapshot return the fake_data snapshot of a game changed to the given
+// status, period, clock and score
+func snapshot(t *testing.T, status string, period int, clock string, home int, away int) *GamePlayerInfo {
+	t.Helper()
+	info, err := NewFileDataSource("fake_data").GetNBAGamePlayerByGameID(fixtureGameID, "zh_TW")
+	if err != nil {
+		t.Fatalf("GetNBAGamePlayerByGameID error: %v", err)
+	}
+	box := &info.Payload.Boxscore
+	box.Status = status
+	box.Period = strconv.Itoa(period)
+	box.PeriodClock = clock
+	box.HomeScore, box.AwayScore = home, away
+	return info
+}
+
+func TestDiffGame(t *testing.T) {
+	type event struct {
+		Type   GameEventType
+		Period int
+	}
+	tests := []struct {
+		name string
+		prev *gameState
+		cur  func(t *testing.T) *GamePlayerInfo
+		want []event
+	}{
+		{
+			name: "first observation sets the baseline",
+			prev: nil,
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 4, "01:00", 90, 88) },
+		},
+		{
+			name: "game started",
+			prev: &gameState{status: GameStatusScheduled},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 1, "11:40", 2, 0) },
+			want: []event{{GameEventStarted, 1}},
+		},
+		{
+			name: "game final",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 3},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusFinal, 4, "00:00", 97, 96) },
+			want: []event{{GameEventFinal, 4}},
+		},
+		{
+			name: "final is reported once",
+			prev: &gameState{status: GameStatusFinal, period: 4, leader: 1, endedPeriod: 4},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusFinal, 4, "00:00", 97, 96) },
+		},
+		{
+			name: "period ended",
+			prev: &gameState{status: GameStatusLive, period: 1, leader: 1},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 1, "00:00", 30, 25) },
+			want: []event{{GameEventPeriodEnded, 1}},
+		},
+		{
+			name: "period end is reported once",
+			prev: &gameState{status: GameStatusLive, period: 1, leader: 1, endedPeriod: 1},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 1, "00:00", 30, 25) },
+		},
+		{
+			name: "period end missed by the polls",
+			prev: &gameState{status: GameStatusLive, period: 1, leader: 1},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 2, "11:00", 32, 25) },
+			want: []event{{GameEventPeriodEnded, 1}},
+		},
+		{
+			name: "lead change",
+			prev: &gameState{status: GameStatusLive, period: 2, leader: 1, endedPeriod: 1},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 2, "05:00", 50, 52) },
+			want: []event{{GameEventLeadChange, 2}},
+		},
+		{
+			name: "a tie keeps the leader",
+			prev: &gameState{status: GameStatusLive, period: 2, leader: 1, endedPeriod: 1},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 2, "05:00", 52, 52) },
+		},
+		{
+			name: "close game",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 3},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 4, "03:00", 90, 87) },
+			want: []event{{GameEventCloseGame, 4}},
+		},
+		{
+			name: "close game is reported once a period",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 3, closeGamePeriod: 4},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 4, "02:00", 90, 88) },
+		},
+		{
+			name: "not close with time left",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 3},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 4, "06:00", 90, 87) },
+		},
+		{
+			name: "not close by the margin",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 3},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 4, "03:00", 90, 84) },
+		},
+		{
+			name: "overtime",
+			prev: &gameState{status: GameStatusLive, period: 4, leader: 1, endedPeriod: 4, closeGamePeriod: 4},
+			cur:  func(t *testing.T) *GamePlayerInfo { return snapshot(t, GameStatusLive, 5, "04:50", 100, 100) },
+			want: []event{{GameEventOvertime, 5}, {GameEventCloseGame, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur, events := diffGame(tt.prev, tt.cur(t))
+			if cur == nil {
+				t.Fatal("diffGame returned no state")
+			}
+			got := []event{}
+			for _, e := range events {
+				if e.GameID != fixtureGameID {
+					t.Errorf("event %s GameID = %q, want %q", e, e.GameID, fixtureGameID)
+				}
+				got = append(got, event{e.Type, e.Period})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("diffGame events = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("diffGame events = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestDiffGameBaseline(t *testing.T) {
+	// a game first seen at the end of a period must not report it again
+	state, _ := diffGame(nil, snapshot(t, GameStatusLive, 2, "00:00", 50, 48))
+	if _, events := diffGame(state, snapshot(t, GameStatusLive, 2, "00:00", 50, 48)); len(events) != 0 {
+		t.Errorf("diffGame events = %v, want none", events)
+	}
+	state, _ = diffGame(nil, snapshot(t, GameStatusLive, 2, "06:00", 50, 48))
+	_, events := diffGame(state, snapshot(t, GameStatusLive, 2, "00:00", 50, 48))
+	if len(events) != 1 || events[0].Type != GameEventPeriodEnded || events[0].Period != 2 {
+		t.Errorf("diffGame events = %v, want the end of period 2", events)
+	}
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+// PostbackVersion is the version of the postback data format written by
+// PostbackData.Encode
+const PostbackVersion = 1
+
+var (
+	UnknownPostbackStr = "這個按鈕已失效，請輸入 NBA 重新開啟選單"
+	NoHighlightsStr    = "%s vs %s 尚無 Highlights"
+	HighlightsStr      = "%s vs %s Highlights:\n %s"
+	RefreshBoxscoreStr = "更新數據統計"
+)
+
+type PostbackAction string
+
+const (
+	// PostbackPlayer reply the box score image of a team of the game
+	PostbackPlayer PostbackAction = "player"
+	// PostbackScore reply the refreshed score of the game, or the refreshed
+	// box score image of Team
+	PostbackScore PostbackAction = "score"
+	// PostbackHighlights reply the highlights link of the game
+	PostbackHighlights PostbackAction = "highlights"
+	// PostbackGameMenu reply the menu of the views of the game
+	PostbackGameMenu PostbackAction = "menu"
+	// PostbackLineScore reply the line score image of the game
+	PostbackLineScore PostbackAction = "linescore"
+	// PostbackTeamCompare reply the team stats comparison image of the game
+	PostbackTeamCompare PostbackAction = "compare"
+	// PostbackGameLeaders reply the leaders image of the game
+	PostbackGameLeaders PostbackAction = "leaders"
+	// PostbackCommand run a text command
+	PostbackCommand PostbackAction = "cmd"
+	// PostbackSchedule reply the games of Date, or of the date picked by a
+	// datetime picker
+	PostbackSchedule PostbackAction = "schedule"
+	// postbackEcho only comes from legacy "echo@msg@<text>" postbacks
+	postbackEcho PostbackAction = "echo"
+)
+
+// PostbackData is the data of a postback button, encoded as a query string
+// like "v=1&a=player&g=0021700784&t=home". Postback data is limited to 300
+// characters, so it only carries IDs.
+type PostbackData struct {
+	Version int
+	Action  PostbackAction
+	GameID  string
+	// Team is "home" or "away"
+	Team    string
+	Command string
+	// Date is YYYY-MM-DD
+	Date string
+	// Text is only set by legacy echo postbacks
+	Text string
+}
+
+var errMalformedPostback = errors.New("malformed postback")
+
+func (d PostbackData) Encode() string {
+	values := url.Values{}
+	values.Set("v", strconv.Itoa(PostbackVersion))
+	values.Set("a", string(d.Action))
+	if d.GameID != "" {
+		values.Set("g", d.GameID)
+	}
+	if d.Team != "" {
+		values.Set("t", d.Team)
+	}
+	if d.Command != "" {
+		values.Set("c", d.Command)
+	}
+	if d.Date != "" {
+		values.Set("d", d.Date)
+	}
+	return values.Encode()
+}
+
+// DecodePostback decode the data of Encode, or of the legacy
+// "<type>@<action>@<payload>" format
+func DecodePostback(data string) (*PostbackData, error) {
+	values, err := url.ParseQuery(data)
+	if err != nil || values.Get("v") == "" {
+		return decodeLegacyPostback(data)
+	}
+	version, err := strconv.Atoi(values.Get("v"))
+	if err != nil || version < 1 || version > PostbackVersion {
+		return nil, fmt.Errorf("%w: version %q", errMalformedPostback, values.Get("v"))
+	}
+	return &PostbackData{
+		Version: version,
+		Action:  PostbackAction(values.Get("a")),
+		GameID:  values.Get("g"),
+		Team:    values.Get("t"),
+		Command: values.Get("c"),
+		Date:    values.Get("d"),
+	}, nil
+}
+
+func decodeLegacyPostback(data string) (*PostbackData, error) {
+	parts := strings.SplitN(data, "@", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: %q", errMalformedPostback, data)
+	}
+	d := &PostbackData{Action: PostbackAction(parts[0])}
+	switch d.Action {
+	case PostbackPlayer:
+		d.Team, d.GameID = parts[1], parts[2]
+	case PostbackScore:
+		d.GameID = parts[2]
+		// "score@home@<id>" refresh a box score. The "更新比分" buttons sent
+		// before versioned postbacks are "score@update@<id>": they name no
+		// team, so they keep refreshing the score carousel like the
+		// "a=score&g=<id>" buttons replacing them.
+		if parts[1] == "home" || parts[1] == "away" {
+			d.Team = parts[1]
+		}
+	case postbackEcho:
+		d.Text = parts[2]
+	default:
+		return nil, fmt.Errorf("%w: %q", errMalformedPostback, data)
+	}
+	return d, nil
+}
+
+// PostbackHandler build the reply of a postback with the settings of the
+// chat. A returned error is logged and answered with sourceErrorText.
+type PostbackHandler func(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error)
+
+func (app *NBABotClient) postbackHandlers() map[PostbackAction]PostbackHandler {
+	return map[PostbackAction]PostbackHandler{
+		PostbackPlayer:      app.postbackPlayer,
+		PostbackScore:       app.postbackScore,
+		PostbackHighlights:  app.postbackHighlights,
+		PostbackGameMenu:    app.postbackGameMenu,
+		PostbackLineScore:   app.postbackLineScore,
+		PostbackTeamCompare: app.postbackTeamCompare,
+		PostbackGameLeaders: app.postbackGameLeaders,
+		PostbackCommand:     app.postbackCommand,
+		PostbackSchedule:    app.postbackSchedule,
+		postbackEcho:        app.postbackEcho,
+	}
+}
+
+// handlePostBack reply a postback, params are the values chosen with a
+// datetime picker
+func (app *NBABotClient) handlePostBack(data string, params *linebot.Params, replyToken string, source *linebot.EventSource) {
+	var sendMsg linebot.SendingMessage
+	settings := app.chatSettings(chatIDOf(source))
+	locale := settings.ReplyLocale()
+	postback, err := DecodePostback(data)
+	if err == nil && params != nil && params.Date != "" {
+		postback.Date = params.Date
+	}
+	if err != nil {
+		// legacy menu buttons also send their command as text, which the
+		// message event already answered
+		if cmd, _ := app.commands.Match(data); cmd != nil {
+			return
+		}
+		log.Printf("handlePostBack %v", err)
+		app.CounterIncs("未知的postback")
+		sendMsg = linebot.NewTextMessage(T(locale, UnknownPostbackStr))
+	} else if handler, ok := app.postbackHandlers()[postback.Action]; !ok {
+		log.Printf("handlePostBack unknown action %q", data)
+		app.CounterIncs("未知的postback")
+		sendMsg = linebot.NewTextMessage(T(locale, UnknownPostbackStr))
+	} else if sendMsg, err = handler(postback, source, settings); err != nil {
+		log.Printf("handlePostBack %q error: %v", data, err)
+		sendMsg = linebot.NewTextMessage(commandErrorText(err, locale))
+	}
+	if sendMsg == nil {
+		return
+	}
+	if settings.TextOnly {
+		sendMsg = textOnlyMessage(sendMsg)
+	}
+	if _, err := app.bot.ReplyMessage(
+		replyToken,
+		sendMsg,
+	).Do(); err != nil {
+		log.Printf("handlePostBack reply error: %v", err)
+	}
+}
+
+func (app *NBABotClient) postbackPlayer(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	if data.Team != "home" && data.Team != "away" {
+		return nil, newSourceError(ErrNotFound, "postback player", fmt.Errorf("team %q", data.Team))
+	}
+	app.CounterIncs("#比賽數據統計")
+	return app.boxscoreImageMessage(data, settings), nil
+}
+
+// postbackScore refresh the score carousel of the game, or the box score
+// image of data.Team
+func (app *NBABotClient) postbackScore(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	locale := settings.ReplyLocale()
+	if data.Team == "home" || data.Team == "away" {
+		app.CounterIncs(RefreshBoxscoreStr)
+		return app.boxscoreImageMessage(data, settings), nil
+	}
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(data.GameID, locale)
+	if err != nil {
+		return nil, err
+	}
+	app.CounterIncs("更新比分")
+	return app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
+		data:     parseGamePlayerInfoToGameScoreInfo(pInfo),
+		showList: false,
+		locale:   locale,
+		zone:     settings.Zone(),
+	}), nil
+}
+
+// boxscoreImageMessage reply the box score image of data.Team, with a refresh
+// button while the game is in progress
+func (app *NBABotClient) boxscoreImageMessage(data *PostbackData, settings ChatSettings) linebot.SendingMessage {
+	locale := settings.ReplyLocale()
+	image := app.imageMessage("/game/"+url.PathEscape(data.GameID)+"/"+data.Team, settings)
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(data.GameID, locale)
+	if err != nil {
+		log.Printf("boxscoreImageMessage GetNBAGamePlayerByGameID err: %v", err)
+		return image
+	}
+	if pInfo.Payload.Boxscore.Status != GameStatusLive {
+		return image
+	}
+	refresh := PostbackData{Action: PostbackScore, GameID: data.GameID, Team: data.Team}.Encode()
+	return image.WithQuickReplies(linebot.NewQuickReplyItems(
+		linebot.NewQuickReplyButton("", linebot.NewPostbackAction(T(locale, RefreshBoxscoreStr), refresh, "", T(locale, RefreshBoxscoreStr))),
+	))
+}
+
+func (app *NBABotClient) postbackHighlights(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	locale := settings.ReplyLocale()
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(data.GameID, locale)
+	if err != nil {
+		return nil, err
+	}
+	app.CounterIncs("Highlights")
+	game := parseGamePlayerInfoToGameScoreInfo(pInfo)[0]
+	if game.HighlightsURL == "" {
+		return linebot.NewTextMessage(Tf(locale, NoHighlightsStr, game.HomeTeamName, game.AwayTeamName)), nil
+	}
+	return linebot.NewTextMessage(Tf(locale, HighlightsStr, game.HomeTeamName, game.AwayTeamName, game.HighlightsURL)), nil
+}
+
+func (app *NBABotClient) postbackGameMenu(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	locale := settings.ReplyLocale()
+	pInfo, err := app.source.GetNBAGamePlayerByGameID(data.GameID, locale)
+	if err != nil {
+		return nil, err
+	}
+	app.CounterIncs(GameMenuStr)
+	return app.ParseGameMenuToMessage(pInfo, locale, settings.Zone()), nil
+}
+
+func (app *NBABotClient) postbackLineScore(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	app.CounterIncs(LineScoreStr)
+	return app.imageMessage("/game/"+url.PathEscape(data.GameID)+"/linescore", settings), nil
+}
+
+func (app *NBABotClient) postbackTeamCompare(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	app.CounterIncs(TeamCompareStr)
+	return app.imageMessage("/game/"+url.PathEscape(data.GameID)+"/compare", settings), nil
+}
+
+func (app *NBABotClient) postbackGameLeaders(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	app.CounterIncs(GameLeadersStr)
+	return app.imageMessage("/game/"+url.PathEscape(data.GameID)+"/leaders", settings), nil
+}
+
+// postbackCommand run data.Command as if it was typed
+func (app *NBABotClient) postbackCommand(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	locale := settings.ReplyLocale()
+	if cmd, _ := app.commands.Match(data.Command); cmd == nil {
+		return linebot.NewTextMessage(T(locale, UnknownPostbackStr)), nil
+	}
+	return app.commands.Dispatch(linebot.NewTextMessage(data.Command), source, settings)
+}
+
+// postbackSchedule run the schedule command of data.Date
+func (app *NBABotClient) postbackSchedule(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	if data.Date == "" {
+		return nil, fmt.Errorf("%w: schedule without date", errMalformedPostback)
+	}
+	return app.commands.Dispatch(linebot.NewTextMessage(ScheduleStr+" "+data.Date), source, settings)
+}
+
+func (app *NBABotClient) postbackEcho(data *PostbackData, source *linebot.EventSource, settings ChatSettings) (linebot.SendingMessage, error) {
+	return linebot.NewTextMessage(data.Text), nil
+}
+
+// commandPostbackAction is a button running cmd, showing label in the chat
+func commandPostbackAction(label string, cmd string) *linebot.PostbackAction {
+	data := PostbackData{Action: PostbackCommand, Command: cmd}
+	return linebot.NewPostbackAction(label, data.Encode(), "", label)
+}
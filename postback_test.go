@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPostbackRoundTrip(t *testing.T) {
+	tests := []PostbackData{
+		{Action: PostbackPlayer, GameID: "0021700784", Team: "home"},
+		{Action: PostbackScore, GameID: "0021700784"},
+		{Action: PostbackGameMenu, GameID: "0021700784"},
+		{Action: PostbackCommand, Command: CmdTodayGame},
+		{Action: PostbackCommand, Command: "賽程 下週 & 明天=?"},
+		{Action: PostbackSchedule},
+		{Action: PostbackSchedule, Date: "2026-10-18"},
+	}
+	for _, tt := range tests {
+		data := tt.Encode()
+		if len(data) > 300 {
+			t.Errorf("Encode(%+v) is %d characters, more than a postback may carry", tt, len(data))
+		}
+		got, err := DecodePostback(data)
+		if err != nil {
+			t.Errorf("DecodePostback(%q) error: %v", data, err)
+			continue
+		}
+		want := tt
+		want.Version = PostbackVersion
+		if *got != want {
+			t.Errorf("DecodePostback(%q) = %+v, want %+v", data, *got, want)
+		}
+	}
+}
+
+func TestDecodePostback(t *testing.T) {
+	tests := []struct {
+		data string
+		want *PostbackData
+		err  bool
+	}{
+		{"v=1&a=player&g=0021700784&t=away", &PostbackData{Version: 1, Action: PostbackPlayer, GameID: "0021700784", Team: "away"}, false},
+		{"a=score&g=0021700784&v=1", &PostbackData{Version: 1, Action: PostbackScore, GameID: "0021700784"}, false},
+		// unknown actions are decoded, the handler lookup rejects them
+		{"v=1&a=unknown", &PostbackData{Version: 1, Action: "unknown"}, false},
+		{"v=0&a=player", nil, true},
+		{"v=2&a=player", nil, true},
+		{"v=x&a=player", nil, true},
+
+		// legacy "<type>@<action>@<payload>" buttons
+		{"player@home@0021700784", &PostbackData{Action: PostbackPlayer, GameID: "0021700784", Team: "home"}, false},
+		{"player@away@0021700784", &PostbackData{Action: PostbackPlayer, GameID: "0021700784", Team: "away"}, false},
+		{"score@home@0021700784", &PostbackData{Action: PostbackScore, GameID: "0021700784", Team: "home"}, false},
+		{"score@away@0021700784", &PostbackData{Action: PostbackScore, GameID: "0021700784", Team: "away"}, false},
+		// old buttons without a team keep refreshing the score carousel
+		{"score@update@0021700784", &PostbackData{Action: PostbackScore, GameID: "0021700784"}, false},
+		{"echo@text@a1今日賽事@2", &PostbackData{Action: postbackEcho, Text: "a1今日賽事@2"}, false},
+		{"menu@x@0021700784", nil, true},
+		{"player@home", nil, true},
+		{"", nil, true},
+		{"%zz", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := DecodePostback(tt.data)
+		if tt.err {
+			if !errors.Is(err, errMalformedPostback) {
+				t.Errorf("DecodePostback(%q) error = %v, want %v", tt.data, err, errMalformedPostback)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("DecodePostback(%q) error: %v", tt.data, err)
+			continue
+		}
+		if *got != *tt.want {
+			t.Errorf("DecodePostback(%q) = %+v, want %+v", tt.data, *got, *tt.want)
+		}
+	}
+}
+
+func TestDecodeLegacyPostback(t *testing.T) {
+	// the legacy decoder never reads the query string format
+	if _, err := decodeLegacyPostback("v=1&a=player&g=0021700784&t=home"); !errors.Is(err, errMalformedPostback) {
+		t.Errorf("decodeLegacyPostback of a v1 postback error = %v, want %v", err, errMalformedPostback)
+	}
+	got, err := decodeLegacyPostback("player@home@0021700784")
+	if err != nil {
+		t.Fatalf("decodeLegacyPostback error: %v", err)
+	}
+	if got.Version != 0 {
+		t.Errorf("decodeLegacyPostback Version = %d, want 0", got.Version)
+	}
+}
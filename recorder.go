@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recorder save upstream responses as <dir>/<key>/<unix millis>.json, the
+// layout ReplayDataSource reads back.
+type Recorder struct {
+	dir string
+}
+
+func NewRecorder(dir string) *Recorder {
+	return &Recorder{dir: dir}
+}
+
+func (r *Recorder) Save(key string, at time.Time, body []byte) error {
+	keyDir := filepath.Join(r.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(keyDir, 0777); err != nil {
+		return err
+	}
+	name := strconv.FormatInt(at.UnixNano()/int64(time.Millisecond), 10) + ".json"
+	return ioutil.WriteFile(filepath.Join(keyDir, name), body, 0666)
+}
+
+// recordedFile is one response saved by the Recorder
+type recordedFile struct {
+	path string
+	at   time.Time
+}
+
+// listRecordedFiles return the responses recorded for key, oldest first
+func listRecordedFiles(dir string, key string) ([]recordedFile, error) {
+	keyDir := filepath.Join(dir, filepath.FromSlash(key))
+	infos, err := ioutil.ReadDir(keyDir)
+	if err != nil {
+		return nil, err
+	}
+	files := []recordedFile{}
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		millis, err := strconv.ParseInt(strings.TrimSuffix(name, ".json"), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, recordedFile{
+			path: filepath.Join(keyDir, name),
+			at:   time.Unix(0, millis*int64(time.Millisecond)),
+		})
+	}
+	// ReadDir sort by name, which is not numeric order
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].at.Before(files[j].at)
+	})
+	return files, nil
+}
+
+// CaptureLocales are the locales fetched while capturing, one per message
+// catalog
+var CaptureLocales = []string{LocaleZhTW, LocaleEN}
+
+// RunCapture record today's games through source every interval until all of
+// them are final. Standings and the bracket are recorded once.
+func RunCapture(source *HTTPDataSource, interval time.Duration) error {
+	if source.recorder == nil {
+		return fmt.Errorf("capture: record_dir not set")
+	}
+	for _, locale := range CaptureLocales {
+		if _, err := source.GetNBAConferenceStanding(locale); err != nil {
+			log.Printf("capture: standing %s error %v", locale, err)
+		}
+		if _, err := source.GetNBAPlayoffs(locale); err != nil {
+			log.Printf("capture: bracket %s error %v", locale, err)
+		}
+	}
+
+	captured := map[string]bool{}
+	for {
+		data, err := source.GetNBAGameToday(DefaultLocale)
+		if err != nil {
+			log.Printf("capture: scores error %v", err)
+			time.Sleep(interval)
+			continue
+		}
+		for _, locale := range CaptureLocales {
+			if locale == DefaultLocale {
+				continue
+			}
+			if _, err := source.GetNBAGameToday(locale); err != nil {
+				log.Printf("capture: scores %s error %v", locale, err)
+			}
+		}
+		finished := true
+		for _, game := range data.Payload.Date.Games {
+			gameID := game.Profile.GameID
+			status := game.Boxscore.Status
+			if status != GameStatusFinal {
+				finished = false
+			}
+			// scheduled and final games do not change, fetch them once
+			if status != GameStatusLive && captured[gameID+status] {
+				continue
+			}
+			for _, locale := range CaptureLocales {
+				if _, err := source.GetNBAGamePlayerByGameID(gameID, locale); err != nil {
+					log.Printf("capture: snapshot %s error %v", gameID, err)
+				}
+			}
+			captured[gameID+status] = true
+		}
+		log.Printf("capture: %d games recorded", len(data.Payload.Date.Games))
+		if finished {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
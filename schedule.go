@@ -0,0 +1,323 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	ScheduleStr        = "賽程"
+	SchedulePickStr    = "選擇日期"
+	ScheduleUsageStr   = "請輸入日期，例如：賽程 3/15、賽程 下週一、賽程 週末、賽程 未來7天"
+	ScheduleBadDateStr = "看不懂的日期：%s"
+	ScheduleTooLongStr = "一次最多查詢 %d 天"
+	ScheduleTitleStr   = "%s - %s 賽程"
+	ScheduleNoGameStr  = "無賽事"
+
+	NoGameStr            = "當日無賽事"
+	NextGameDayStr       = "下一個比賽日：%s"
+	NextGameDayButtonStr = "查看 %s 賽事"
+	AllStarBreakStr      = "明星賽假期，賽事將於 %s 恢復"
+	OffSeasonStr         = "休賽季，%s將於 %s 開打"
+)
+
+// Season types of the season block and the game profiles
+const (
+	SeasonTypePreseason = 1
+	SeasonTypeRegular   = 2
+	SeasonTypeAllStar   = 3
+	SeasonTypePlayoffs  = 4
+)
+
+const (
+	// offSeasonGap is the longest gap between two game days of a season
+	offSeasonGap = 30 * 24 * time.Hour
+	// allStarBreakGap is the longest gap between two game days of the regular
+	// season but the All-Star break
+	allStarBreakGap = 3 * 24 * time.Hour
+)
+
+// Weekdays are the names of the days, indexed by time.Weekday
+var Weekdays = []string{"週日", "週一", "週二", "週三", "週四", "週五", "週六"}
+
+// maxScheduleDays is the longest range of a schedule query, each day is one
+// upstream request
+const maxScheduleDays = 10
+
+// maxTextMessageLength is the limit of LINE on the characters of a text
+// message
+const maxTextMessageLength = 5000
+
+var (
+	fullDatePattern  = regexp.MustCompile(`^(\d{4})[-/.](\d{1,2})[-/.](\d{1,2})$`)
+	monthDayPattern  = regexp.MustCompile(`^(\d{1,2})[/-](\d{1,2})$`)
+	nextDaysPattern  = regexp.MustCompile(`^(?:NEXT|未來|接下來)?(\d+)(?:DAYS?|天)$`)
+	lastDaysPattern  = regexp.MustCompile(`^(?:LAST|PAST|過去)(\d+)(?:DAYS?|天)$`)
+	zhWeekdayPattern = regexp.MustCompile(`^(下|下個|這|這個|本)?(?:週|周|星期|禮拜)([一二三四五六日天])$`)
+)
+
+var zhWeekdays = map[string]time.Weekday{
+	"日": time.Sunday, "天": time.Sunday, "一": time.Monday, "二": time.Tuesday,
+	"三": time.Wednesday, "四": time.Thursday, "五": time.Friday, "六": time.Saturday,
+}
+
+// parseDateRange parse query to the first day and the number of days it
+// names, the days starting at midnight in the zone of now. Weeks start on
+// Monday: on a Sunday 下週一 is tomorrow.
+func parseDateRange(query string, now time.Time) (time.Time, int, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	// "NEXT 7 DAYS" is NEXT7DAYS
+	q := strings.Join(strings.Fields(strings.ToUpper(query)), "")
+
+	switch q {
+	case "今天", "今日", "TODAY":
+		return today, 1, true
+	case "明天", "明日", "TOMORROW":
+		return today.AddDate(0, 0, 1), 1, true
+	case "昨天", "昨日", "YESTERDAY":
+		return today.AddDate(0, 0, -1), 1, true
+	case "週末", "周末", "這週末", "本週末", "WEEKEND", "THISWEEKEND":
+		saturday := monday.AddDate(0, 0, 5)
+		if today.After(saturday) {
+			return today, 1, true
+		}
+		return saturday, 2, true
+	case "下週末", "下周末", "NEXTWEEKEND":
+		return monday.AddDate(0, 0, 12), 2, true
+	case "這週", "本週", "這周", "本周", "THISWEEK":
+		return today, 7 - (int(today.Weekday())+6)%7, true
+	case "下週", "下周", "NEXTWEEK":
+		return monday.AddDate(0, 0, 7), 7, true
+	}
+
+	if m := fullDatePattern.FindStringSubmatch(q); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		day, _ := strconv.Atoi(m[3])
+		date, ok := makeDate(year, month, day, now.Location())
+		return date, 1, ok
+	}
+	if m := monthDayPattern.FindStringSubmatch(q); m != nil {
+		month, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+		// the season spans two years, 3/15 in October is next March: the
+		// date is the existing one closest to today, 2/29 may be next year's
+		distance := func(date time.Time) time.Duration {
+			if d := date.Sub(today); d >= 0 {
+				return d
+			}
+			return today.Sub(date)
+		}
+		date, found := time.Time{}, false
+		for year := today.Year() - 1; year <= today.Year()+1; year++ {
+			if d, ok := makeDate(year, month, day, now.Location()); ok && (!found || distance(d) < distance(date)) {
+				date, found = d, true
+			}
+		}
+		return date, 1, found
+	}
+	if m := nextDaysPattern.FindStringSubmatch(q); m != nil {
+		days, err := strconv.Atoi(m[1])
+		return today, days, err == nil && days > 0
+	}
+	if m := lastDaysPattern.FindStringSubmatch(q); m != nil {
+		days, err := strconv.Atoi(m[1])
+		return today.AddDate(0, 0, 1-days), days, err == nil && days > 0
+	}
+	if m := zhWeekdayPattern.FindStringSubmatch(q); m != nil {
+		return weekdayDate(today, monday, m[1], zhWeekdays[m[2]]), 1, true
+	}
+	prefix := ""
+	for _, p := range []string{"NEXT", "THIS"} {
+		if strings.HasPrefix(q, p) {
+			prefix, q = p, strings.TrimPrefix(q, p)
+		}
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToUpper(weekday.String())
+		if q == name || q == name[:3] {
+			return weekdayDate(today, monday, prefix, weekday), 1, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+// weekdayDate return weekday of this week for 這 and THIS, of next week for
+// 下 and NEXT, or the first weekday from today
+func weekdayDate(today time.Time, monday time.Time, prefix string, weekday time.Weekday) time.Time {
+	offset := (int(weekday) + 6) % 7
+	switch prefix {
+	case "下", "下個", "NEXT":
+		return monday.AddDate(0, 0, 7+offset)
+	case "這", "這個", "本", "THIS":
+		return monday.AddDate(0, 0, offset)
+	}
+	return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7)
+}
+
+// makeDate return the date, or false if the day does not exist in the month
+func makeDate(year int, month int, day int, zone *time.Location) (time.Time, bool) {
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, zone)
+	return date, date.Month() == time.Month(month) && date.Day() == day
+}
+
+// cmdSchedule reply the games of the day the query names, or a summary of
+// the games of a range of days
+func (app *NBABotClient) cmdSchedule(ctx *CommandContext) (linebot.SendingMessage, error) {
+	query := ctx.Args.Query
+	if query == "" {
+		return linebot.NewTextMessage(T(ctx.Locale, ScheduleUsageStr)).WithQuickReplies(scheduleQuickReplies(ctx.Locale)), nil
+	}
+	zone := ctx.Settings.Zone()
+	start, days, ok := parseDateRange(query, time.Now().In(zone))
+	if !ok {
+		return linebot.NewTextMessage(Tf(ctx.Locale, ScheduleBadDateStr, query)).WithQuickReplies(scheduleQuickReplies(ctx.Locale)), nil
+	}
+	if days > maxScheduleDays {
+		return linebot.NewTextMessage(Tf(ctx.Locale, ScheduleTooLongStr, maxScheduleDays)), nil
+	}
+	if days == 1 {
+		data, err := app.gamesOn(start, ctx.Locale)
+		if err != nil {
+			return nil, err
+		}
+		return app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
+			data:     favoriteFirst(data, parseGameInfoToGameScoreInfo(data), ctx.Settings.FavoriteTeamID),
+			cmd:      ScheduleStr + " " + start.Format(NBA_API_TIME_FORMAT),
+			page:     ctx.Args.Page,
+			showList: true,
+			locale:   ctx.Locale,
+			day:      data,
+			zone:     zone,
+		}), nil
+	}
+
+	data := []*GameInfo{}
+	for day := 0; day < days; day++ {
+		gameInfo, err := app.gamesOn(start.AddDate(0, 0, day), ctx.Locale)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, gameInfo)
+	}
+	message := linebot.NewTextMessage(scheduleText(start, data, ctx.Settings.FavoriteTeamID, ctx.Locale, zone))
+	// a button per game day opens its carousel
+	items := []*linebot.QuickReplyButton{}
+	for day, gameInfo := range data {
+		if len(gameInfo.Payload.Date.Games) == 0 {
+			continue
+		}
+		date := start.AddDate(0, 0, day)
+		items = append(items, linebot.NewQuickReplyButton("", commandPostbackAction(scheduleDay(date, ctx.Locale), ScheduleStr+" "+date.Format(NBA_API_TIME_FORMAT))))
+	}
+	if len(items) == 0 {
+		return message, nil
+	}
+	return message.WithQuickReplies(linebot.NewQuickReplyItems(items...)), nil
+}
+
+// scheduleText list the games of each day from start, the games of teamID
+// first
+func scheduleText(start time.Time, data []*GameInfo, teamID string, locale string, zone *time.Location) string {
+	end := start.AddDate(0, 0, len(data)-1)
+	lines := []string{Tf(locale, ScheduleTitleStr, scheduleDay(start, locale), scheduleDay(end, locale))}
+	for day, gameInfo := range data {
+		lines = append(lines, "", scheduleDay(start.AddDate(0, 0, day), locale))
+		games := favoriteFirst(gameInfo, parseGameInfoToGameScoreInfo(gameInfo), teamID)
+		if len(games) == 0 {
+			lines = append(lines, "  "+T(locale, ScheduleNoGameStr))
+			continue
+		}
+		for _, game := range games {
+			lines = append(lines, "  "+scheduleGameText(game, zone))
+		}
+	}
+	return truncateText(strings.Join(lines, "\n"), maxTextMessageLength)
+}
+
+// scheduleGameText is the start time of a scheduled game, or the score
+func scheduleGameText(game *GameScoreInfo, zone *time.Location) string {
+	if game.Boxscore.Status == GameStatusScheduled {
+		return fmt.Sprintf("%s %s vs %s", UtcMillis2TimeString(game.UtcMillis, "15:04", zone), game.HomeTeamName, game.AwayTeamName)
+	}
+	return fmt.Sprintf("%s %d - %d %s %s", game.HomeTeamName, game.Boxscore.HomeScore, game.Boxscore.AwayScore, game.AwayTeamName, game.Boxscore.StatusDesc)
+}
+
+// scheduleDay is the date and the weekday, e.g. "03/15 週日"
+func scheduleDay(date time.Time, locale string) string {
+	return date.Format("01/02") + " " + T(locale, Weekdays[date.Weekday()])
+}
+
+// truncateText cut text to max characters
+func truncateText(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	return string([]rune(text)[:max-1]) + "…"
+}
+
+// noGameMessage reply a day without games with the next game day and a
+// button loading it. A long gap is the off-season or the All-Star break.
+func noGameMessage(data *GameInfo, locale string, zone *time.Location) linebot.SendingMessage {
+	text := T(locale, NoGameStr)
+	next, ok := millisTime(data.Payload.NextAvailableDateMillis)
+	if !ok {
+		return linebot.NewTextMessage(text)
+	}
+	next = next.In(zone)
+	day, ok := millisTime(data.Payload.Date.DateMillis)
+	if !ok {
+		day = time.Now()
+	}
+	nextDay := scheduleDay(next, locale)
+	season := data.Payload.Season
+	switch gap := next.Sub(day); {
+	case gap > offSeasonGap:
+		text += "\n" + Tf(locale, OffSeasonStr, T(locale, seasonStartName(season.ScheduleSeasonType)), nextDay)
+	case gap > allStarBreakGap && (season.ScheduleSeasonType == SeasonTypeRegular || season.ScheduleSeasonType == SeasonTypeAllStar):
+		text += "\n" + Tf(locale, AllStarBreakStr, nextDay)
+	default:
+		text += "\n" + Tf(locale, NextGameDayStr, nextDay)
+	}
+	label := Tf(locale, NextGameDayButtonStr, next.Format("01/02"))
+	load := PostbackData{Action: PostbackSchedule, Date: next.Format(NBA_API_TIME_FORMAT)}.Encode()
+	return linebot.NewTextMessage(text).WithQuickReplies(linebot.NewQuickReplyItems(
+		linebot.NewQuickReplyButton("", linebot.NewPostbackAction(label, load, "", label)),
+	))
+}
+
+// seasonStartName name the games starting after the off-season, the season
+// block still being the finished season once the playoffs are over
+func seasonStartName(seasonType int) string {
+	switch seasonType {
+	case SeasonTypePreseason:
+		return "季前賽"
+	case SeasonTypeRegular:
+		return "例行賽"
+	}
+	return "新賽季"
+}
+
+// scheduleDatePickerAction is a button choosing the date of the schedule
+func scheduleDatePickerAction(locale string) *linebot.DatetimePickerAction {
+	data := PostbackData{Action: PostbackSchedule}.Encode()
+	return linebot.NewDatetimePickerAction(T(locale, SchedulePickStr), data, "date", "", "", "")
+}
+
+// scheduleQuickReplies suggest a date picker and the common ranges
+func scheduleQuickReplies(locale string) *linebot.QuickReplyItems {
+	items := []*linebot.QuickReplyButton{
+		linebot.NewQuickReplyButton("", scheduleDatePickerAction(locale)),
+	}
+	for _, query := range []string{"週末", "下週", "未來7天"} {
+		items = append(items, linebot.NewQuickReplyButton("", commandPostbackAction(T(locale, query), ScheduleStr+" "+query)))
+	}
+	return linebot.NewQuickReplyItems(items...)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateRange(t *testing.T) {
+	zone := time.FixedZone("CST", 8*60*60)
+	// a Sunday afternoon
+	sunday := time.Date(2026, 10, 18, 15, 4, 5, 0, zone)
+	wednesday := time.Date(2026, 10, 21, 9, 0, 0, 0, zone)
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, zone)
+	}
+
+	tests := []struct {
+		query string
+		now   time.Time
+		start time.Time
+		days  int
+		ok    bool
+	}{
+		{"今天", sunday, day(2026, 10, 18), 1, true},
+		{"TODAY", sunday, day(2026, 10, 18), 1, true},
+		{"明天", sunday, day(2026, 10, 19), 1, true},
+		{"yesterday", sunday, day(2026, 10, 17), 1, true},
+
+		// weeks start on Monday
+		{"下週一", sunday, day(2026, 10, 19), 1, true},
+		{"這週一", sunday, day(2026, 10, 12), 1, true},
+		{"週五", sunday, day(2026, 10, 23), 1, true},
+		{"next monday", sunday, day(2026, 10, 19), 1, true},
+		{"THIS MONDAY", sunday, day(2026, 10, 12), 1, true},
+		{"FRI", sunday, day(2026, 10, 23), 1, true},
+		{"sunday", sunday, day(2026, 10, 18), 1, true},
+		{"週三", wednesday, day(2026, 10, 21), 1, true},
+		{"下週三", wednesday, day(2026, 10, 28), 1, true},
+
+		// ranges
+		{"週末", sunday, day(2026, 10, 18), 1, true},
+		{"週末", wednesday, day(2026, 10, 24), 2, true},
+		{"下週末", sunday, day(2026, 10, 24), 2, true},
+		{"這週", wednesday, day(2026, 10, 21), 5, true},
+		{"下週", sunday, day(2026, 10, 19), 7, true},
+		{"next 7 days", sunday, day(2026, 10, 18), 7, true},
+		{"past 3 days", sunday, day(2026, 10, 16), 3, true},
+		{"next 0 days", sunday, time.Time{}, 0, false},
+
+		// the season spans two years
+		{"3/15", sunday, day(2027, 3, 15), 1, true},
+		{"10/1", sunday, day(2026, 10, 1), 1, true},
+		{"3/15", day(2027, 5, 1), day(2027, 3, 15), 1, true},
+		{"10/20", day(2027, 3, 1), day(2026, 10, 20), 1, true},
+		{"12/31", day(2027, 1, 2), day(2026, 12, 31), 1, true},
+		{"2026-10-01", sunday, day(2026, 10, 1), 1, true},
+		{"2025/3/15", sunday, day(2025, 3, 15), 1, true},
+
+		// leap days
+		{"2/29", day(2027, 10, 18), day(2028, 2, 29), 1, true},
+		{"2/29", day(2028, 3, 1), day(2028, 2, 29), 1, true},
+		{"2/29", sunday, time.Time{}, 0, false},
+		{"2028/2/29", sunday, day(2028, 2, 29), 1, true},
+		{"2027/2/29", sunday, time.Time{}, 0, false},
+
+		// invalid dates
+		{"2/30", sunday, time.Time{}, 0, false},
+		{"13/1", sunday, time.Time{}, 0, false},
+		{"4/31", sunday, time.Time{}, 0, false},
+		{"2026/2/30", sunday, time.Time{}, 0, false},
+		{"xx", sunday, time.Time{}, 0, false},
+		{"", sunday, time.Time{}, 0, false},
+	}
+	for _, tt := range tests {
+		start, days, ok := parseDateRange(tt.query, tt.now)
+		if ok != tt.ok {
+			t.Errorf("parseDateRange(%q, %s) ok = %v, want %v", tt.query, tt.now.Format("2006-01-02"), ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !start.Equal(tt.start) || days != tt.days {
+			t.Errorf("parseDateRange(%q, %s) = %s, %d days, want %s, %d days", tt.query, tt.now.Format("2006-01-02"),
+				start.Format("2006-01-02"), days, tt.start.Format("2006-01-02"), tt.days)
+		}
+		if start.Location() != tt.now.Location() {
+			t.Errorf("parseDateRange(%q) zone = %s, want %s", tt.query, start.Location(), tt.now.Location())
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	LanguageStr        = "語言"
+	LanguageQueryStr   = "請選擇語言"
+	LanguageSetStr     = "已切換為中文"
+	LanguageUnknownStr = "不支援的語言：%s"
+
+	SettingsStr              = "設定"
+	SettingsTitleStr         = "目前設定"
+	SettingOnStr             = "開"
+	SettingOffStr            = "關"
+	SettingUnsetStr          = "未設定"
+	SettingClearStr          = "無"
+	SettingTimezoneStr       = "時區"
+	SettingFavoriteTeamStr   = "最愛球隊"
+	SettingNotifyStartStr    = "開賽通知"
+	SettingNotifyHalftimeStr = "中場通知"
+	SettingNotifyFinalStr    = "終場通知"
+	SettingTextOnlyStr       = "純文字模式"
+	SettingMentionOnlyStr    = "標記才回應"
+	SettingFavoriteUsageStr  = "請輸入球隊名稱，例如：設定 最愛球隊 湖人，輸入「設定 最愛球隊 無」取消"
+	SettingTimezoneUsageStr  = "請輸入時區，例如：設定 時區 America/New_York"
+	SettingTimezoneBadStr    = "不支援的時區：%s"
+	SettingUnknownStr        = "不支援的設定：%s"
+)
+
+// chatToggle is an on/off setting of the settings command
+type chatToggle struct {
+	Name string
+	// Field return the field of the setting in s
+	Field func(s *ChatSettings) *bool
+	// Inverted settings are stored as their opposite, 開賽通知 is on when
+	// MuteStart is false
+	Inverted bool
+}
+
+var ChatToggles = []chatToggle{
+	{Name: SettingNotifyStartStr, Field: func(s *ChatSettings) *bool { return &s.MuteStart }, Inverted: true},
+	{Name: SettingNotifyHalftimeStr, Field: func(s *ChatSettings) *bool { return &s.MuteHalftime }, Inverted: true},
+	{Name: SettingNotifyFinalStr, Field: func(s *ChatSettings) *bool { return &s.MuteFinal }, Inverted: true},
+	{Name: SettingTextOnlyStr, Field: func(s *ChatSettings) *bool { return &s.TextOnly }},
+	{Name: SettingMentionOnlyStr, Field: func(s *ChatSettings) *bool { return &s.MentionOnly }},
+}
+
+func (t chatToggle) on(s *ChatSettings) bool {
+	return *t.Field(s) != t.Inverted
+}
+
+func findChatToggle(name string) *chatToggle {
+	for i, toggle := range ChatToggles {
+		if toggle.Name == name {
+			return &ChatToggles[i]
+		}
+	}
+	return nil
+}
+
+// ReplyLocale is the locale of the replies to the chat
+func (s ChatSettings) ReplyLocale() string {
+	return validLocale(s.Locale)
+}
+
+// Zone is the timezone of the dates and times replied to the chat
+func (s ChatSettings) Zone() *time.Location {
+	if s.Timezone == "" {
+		return _localZone
+	}
+	zone, ok := parseTimezone(s.Timezone)
+	if !ok {
+		log.Printf("chat timezone %q error", s.Timezone)
+		return _localZone
+	}
+	return zone
+}
+
+// chatSettings return the settings of the chat, the defaults when they can
+// not be read
+func (app *NBABotClient) chatSettings(chatID string) ChatSettings {
+	settings, err := GetChatSettings(chatID)
+	if err != nil {
+		log.Printf("GetChatSettings error: %v", err)
+	}
+	return settings
+}
+
+// requestLocale return the locale of an image request, set by imageMessage
+func requestLocale(c *gin.Context) string {
+	return validLocale(c.Query("locale"))
+}
+
+// requestZone return the timezone of an image request, set by imageMessage
+func requestZone(c *gin.Context) *time.Location {
+	if tz := c.Query("tz"); tz != "" {
+		if zone, ok := parseTimezone(tz); ok {
+			return zone
+		}
+	}
+	return _localZone
+}
+
+// cmdLanguage save the locale of the chat, or reply the locales to choose from
+func (app *NBABotClient) cmdLanguage(ctx *CommandContext) (linebot.SendingMessage, error) {
+	if ctx.Args.Query == "" {
+		items := []*linebot.QuickReplyButton{}
+		for _, l := range LocaleDisplayNames {
+			items = append(items, linebot.NewQuickReplyButton("", commandPostbackAction(l.Name, LanguageStr+" "+l.Locale)))
+		}
+		return linebot.NewTextMessage(T(ctx.Locale, LanguageQueryStr)).WithQuickReplies(linebot.NewQuickReplyItems(items...)), nil
+	}
+	locale, ok := parseLocale(ctx.Args.Query)
+	if !ok {
+		return linebot.NewTextMessage(Tf(ctx.Locale, LanguageUnknownStr, ctx.Args.Query)), nil
+	}
+	settings, err := GetChatSettings(chatIDOf(ctx.Source))
+	if err != nil {
+		return nil, err
+	}
+	settings.Locale = locale
+	if _, err := SaveChatSettings(settings); err != nil {
+		log.Printf("SaveChatSettings error: %v", err)
+		return linebot.NewTextMessage(T(ctx.Locale, SourceErrorStr)), nil
+	}
+	return linebot.NewTextMessage(T(locale, LanguageSetStr)), nil
+}
+
+// cmdSettings reply the settings of the chat with buttons changing them.
+// "設定 <toggle>" switch a toggle, "設定 最愛球隊 <team>" and
+// "設定 時區 <zone>" set the favorite team and the timezone.
+func (app *NBABotClient) cmdSettings(ctx *CommandContext) (linebot.SendingMessage, error) {
+	query := ctx.Args.Query
+	if query == "" {
+		return settingsMessage(ctx.Settings), nil
+	}
+	settings, err := GetChatSettings(chatIDOf(ctx.Source))
+	if err != nil {
+		return nil, err
+	}
+	var reply linebot.SendingMessage
+	switch {
+	case strings.HasPrefix(query, SettingFavoriteTeamStr):
+		reply = app.setFavoriteTeam(&settings, strings.TrimSpace(strings.TrimPrefix(query, SettingFavoriteTeamStr)))
+	case strings.HasPrefix(query, SettingTimezoneStr):
+		reply = setTimezone(&settings, strings.TrimSpace(strings.TrimPrefix(query, SettingTimezoneStr)))
+	default:
+		toggle := findChatToggle(query)
+		if toggle == nil {
+			return linebot.NewTextMessage(Tf(ctx.Locale, SettingUnknownStr, query)), nil
+		}
+		*toggle.Field(&settings) = !*toggle.Field(&settings)
+	}
+	if reply != nil {
+		return reply, nil
+	}
+	if _, err := SaveChatSettings(settings); err != nil {
+		log.Printf("SaveChatSettings error: %v", err)
+		return linebot.NewTextMessage(T(ctx.Locale, SourceErrorStr)), nil
+	}
+	return settingsMessage(settings), nil
+}
+
+// setFavoriteTeam set the favorite team to the team named by query, or return
+// the reply explaining why it could not
+func (app *NBABotClient) setFavoriteTeam(settings *ChatSettings, query string) linebot.SendingMessage {
+	locale := settings.ReplyLocale()
+	switch query {
+	case "":
+		return linebot.NewTextMessage(T(locale, SettingFavoriteUsageStr))
+	case SettingClearStr, "NONE":
+		settings.FavoriteTeamID, settings.FavoriteTeamName = "", ""
+		return nil
+	}
+	team, rest, err := app.teams.Resolve(query)
+	if err != nil {
+		log.Printf("setFavoriteTeam Resolve error: %v", err)
+		return linebot.NewTextMessage(sourceErrorText(err, locale))
+	}
+	if team == nil || rest != "" {
+		return linebot.NewTextMessage(Tf(locale, TeamNotFoundStr, query))
+	}
+	settings.FavoriteTeamID, settings.FavoriteTeamName = team.ID, team.Name
+	return nil
+}
+
+// setTimezone set the timezone to the zone named by name, or return the reply
+// explaining why it could not
+func setTimezone(settings *ChatSettings, name string) linebot.SendingMessage {
+	locale := settings.ReplyLocale()
+	switch name {
+	case "":
+		return linebot.NewTextMessage(T(locale, SettingTimezoneUsageStr))
+	case SettingClearStr, "NONE":
+		settings.Timezone = ""
+		return nil
+	}
+	zone, ok := parseTimezone(name)
+	if !ok {
+		return linebot.NewTextMessage(Tf(locale, SettingTimezoneBadStr, name))
+	}
+	settings.Timezone = zone.String()
+	return nil
+}
+
+// zoneCache keep the locations found by parseTimezone, which runs on every
+// reply to a chat with a timezone
+var zoneCache = struct {
+	sync.Mutex
+	zones map[string]*time.Location
+}{zones: map[string]*time.Location{}}
+
+// parseTimezone load the IANA zone of name, case insensitive as the commands
+// are upper cased: "AMERICA/NEW_YORK" is America/New_York
+func parseTimezone(name string) (*time.Location, bool) {
+	// no two zone names differ only by case, the lower case name is the key
+	key := strings.ToLower(name)
+	zoneCache.Lock()
+	zone, ok := zoneCache.zones[key]
+	zoneCache.Unlock()
+	if ok {
+		return zone, true
+	}
+	zone, ok = loadTimezone(name)
+	if ok {
+		zoneCache.Lock()
+		zoneCache.zones[key] = zone
+		zoneCache.Unlock()
+	}
+	return zone, ok
+}
+
+// loadTimezone load the location of name, retried title cased
+func loadTimezone(name string) (*time.Location, bool) {
+	if zone, err := time.LoadLocation(name); err == nil {
+		return zone, true
+	}
+	b := []byte(strings.ToLower(name))
+	for i := range b {
+		if (i == 0 || strings.IndexByte("/_-", b[i-1]) >= 0) && b[i] >= 'a' && b[i] <= 'z' {
+			b[i] -= 'a' - 'A'
+		}
+	}
+	zone, err := time.LoadLocation(string(b))
+	if err != nil {
+		return nil, false
+	}
+	return zone, true
+}
+
+// settingsMessage list the settings, with a quick reply changing each of them
+func settingsMessage(settings ChatSettings) linebot.SendingMessage {
+	locale := settings.ReplyLocale()
+	onOff := func(on bool) string {
+		if on {
+			return T(locale, SettingOnStr)
+		}
+		return T(locale, SettingOffStr)
+	}
+	language := locale
+	for _, l := range LocaleDisplayNames {
+		if l.Locale == locale {
+			language = l.Name
+		}
+	}
+	timezone := settings.Timezone
+	if timezone == "" {
+		timezone = _localZone.String()
+	}
+	favorite := settings.FavoriteTeamName
+	if favorite == "" {
+		favorite = T(locale, SettingUnsetStr)
+	}
+
+	lines := []string{
+		T(locale, SettingsTitleStr),
+		Tf(locale, "%s：%s", T(locale, LanguageStr), language),
+		Tf(locale, "%s：%s", T(locale, SettingTimezoneStr), timezone),
+		Tf(locale, "%s：%s", T(locale, SettingFavoriteTeamStr), favorite),
+	}
+	items := []*linebot.QuickReplyButton{
+		linebot.NewQuickReplyButton("", commandPostbackAction(T(locale, LanguageStr), LanguageStr)),
+		linebot.NewQuickReplyButton("", commandPostbackAction(T(locale, SettingTimezoneStr), SettingsStr+" "+SettingTimezoneStr)),
+		linebot.NewQuickReplyButton("", commandPostbackAction(T(locale, SettingFavoriteTeamStr), SettingsStr+" "+SettingFavoriteTeamStr)),
+	}
+	for _, toggle := range ChatToggles {
+		lines = append(lines, Tf(locale, "%s：%s", T(locale, toggle.Name), onOff(toggle.on(&settings))))
+		items = append(items, linebot.NewQuickReplyButton("", commandPostbackAction(T(locale, toggle.Name), SettingsStr+" "+toggle.Name)))
+	}
+	return linebot.NewTextMessage(strings.Join(lines, "\n")).WithQuickReplies(linebot.NewQuickReplyItems(items...))
+}
+
+// botMention return "@<display name>" of the bot, empty when the bot info
+// could not be read
+func (app *NBABotClient) botMention() string {
+	if app.botName == "" {
+		return ""
+	}
+	return "@" + app.botName
+}
+
+// stripMention remove the mention of the bot from text, and tell whether
+// text mentioned it
+func (app *NBABotClient) stripMention(text string) (string, bool) {
+	if !strings.Contains(text, "@") {
+		return text, false
+	}
+	mention := app.botMention()
+	if mention == "" || !strings.Contains(text, mention) {
+		return text, false
+	}
+	return strings.TrimSpace(strings.Replace(text, mention, "", 1)), true
+}
+
+// textOnlyMessage replace an image by its link and a template by its
+// alternative text, for the chats in text-only mode
+func textOnlyMessage(message linebot.SendingMessage) linebot.SendingMessage {
+	switch m := message.(type) {
+	case *linebot.ImageMessage:
+		return linebot.NewTextMessage(m.OriginalContentURL)
+	case *linebot.TemplateMessage:
+		return linebot.NewTextMessage(m.AltText)
+	case *linebot.FlexMessage:
+		return linebot.NewTextMessage(m.AltText)
+	}
+	return message
+}
+
+// favoriteFirst move the games of teamID to the front, games being parsed
+// from data in order
+func favoriteFirst(data *GameInfo, games []*GameScoreInfo, teamID string) []*GameScoreInfo {
+	if teamID == "" {
+		return games
+	}
+	first, rest := []*GameScoreInfo{}, []*GameScoreInfo{}
+	for i, game := range games {
+		profile := data.Payload.Date.Games[i].Profile
+		if profile.HomeTeamID == teamID || profile.AwayTeamID == teamID {
+			first = append(first, game)
+		} else {
+			rest = append(rest, game)
+		}
+	}
+	return append(first, rest...)
+}
@@ -3,9 +3,9 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -14,96 +14,218 @@ const (
 	NBA_API_TIME_FORMAT = "2006-01-02"
 )
 
-func GetNBAGameByDate(date *time.Time) (*GameInfo, error) {
-	nbaquertURL := nbaAPIScoresURL + fmt.Sprintf("&gameDate=%s", date.Format(NBA_API_TIME_FORMAT))
-	return getNBAGame(nbaquertURL)
+// Keys naming each upstream request, shared by the cache, the recorder and
+// the replay source.
+const (
+	scoresTodayKey = "scores/today"
+	standingKey    = "standing"
+	bracketKey     = "bracket"
+)
+
+// scoresKey is the key of the games on date in the zone of date, the keys of
+// the default zone are unchanged so existing recordings still replay
+func scoresKey(date *time.Time) string {
+	key := "scores/" + date.Format(NBA_API_TIME_FORMAT)
+	if offset := date.Format("-0700"); offset != date.In(_localZone).Format("-0700") {
+		key = "tz" + offset + "/" + key
+	}
+	return key
 }
 
-func GetNBAGameToday() (*GameInfo, error) {
-	return getNBAGame(nbaAPIScoresURL)
+// apiTimezone is the UTC offset of t as the scores API takes it, e.g. "+8",
+// "-4" or "+5:30"
+func apiTimezone(t time.Time) string {
+	_, offset := t.Zone()
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	tz := sign + strconv.Itoa(offset/3600)
+	if minutes := offset % 3600 / 60; minutes != 0 {
+		tz += fmt.Sprintf(":%02d", minutes)
+	}
+	return tz
 }
 
-func getNBAGame(url string) (*GameInfo, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		log.Printf("error: get error %v", err)
-		return nil, err
+func snapshotKey(id string, locale string) string {
+	return fmt.Sprintf("snapshot/%s/%s", locale, id)
+}
+
+// localeKey is key for a request in locale, the keys of DefaultLocale are
+// unchanged so existing recordings still replay
+func localeKey(key string, locale string) string {
+	if locale == DefaultLocale {
+		return key
+	}
+	return locale + "/" + key
+}
+
+// DataSource provides NBA data for the bot and the image endpoints, with the
+// names and descriptions in locale.
+type DataSource interface {
+	GetNBAGameToday(locale string) (*GameInfo, error)
+	GetNBAGameByDate(date *time.Time, locale string) (*GameInfo, error)
+	GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error)
+	GetNBAConferenceStanding(locale string) (*ConferenceStanding, error)
+	GetNBAPlayoffs(locale string) (*BracketInfo, error)
+}
+
+// NewDataSource create the DataSource selected by the source config
+func NewDataSource(config *Configuration) (DataSource, error) {
+	switch config.Source["type"] {
+	case "", "http":
+		nbaAPIURL := config.Source["nba_url"]
+		if nbaAPIURL == "" {
+			return nil, fmt.Errorf("config nba_url empty")
+		}
+		source := NewHTTPDataSource(nbaAPIURL, config.HTTPClient)
+		if dir := config.Source["record_dir"]; dir != "" {
+			source.recorder = NewRecorder(dir)
+		}
+		return source, nil
+	case "file":
+		dir := config.Source["file_dir"]
+		if dir == "" {
+			dir = "fake_data"
+		}
+		return NewFileDataSource(dir), nil
+	case "replay":
+		speed := 1.0
+		if v := config.Source["replay_speed"]; v != "" {
+			var err error
+			if speed, err = strconv.ParseFloat(v, 64); err != nil || speed <= 0 {
+				return nil, fmt.Errorf("invalid replay_speed %q", v)
+			}
+		}
+		source, err := NewReplayDataSource(config.Source["replay_dir"], speed)
+		if err != nil {
+			return nil, err
+		}
+		return source, nil
+	default:
+		return nil, fmt.Errorf("unknown source type %q", config.Source["type"])
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		log.Printf("error: get fail %s", resp.Body)
-		return nil, fmt.Errorf("status code error %v", resp.Body)
+}
+
+// HTTPDataSource fetch data from the upstream stats API
+type HTTPDataSource struct {
+	client                *apiClient
+	recorder              *Recorder
+	scoresURL             string
+	gameSnapshotURL       string
+	conferenceStandingURL string
+	bracketURL            string
+}
+
+func NewHTTPDataSource(nbaAPIURL string, config HTTPClientConfig) *HTTPDataSource {
+	return &HTTPDataSource{
+		client:                newAPIClient(config),
+		scoresURL:             nbaAPIURL + "/stats2/scores/daily.json?countryCode=TW&locale=%s&tz=%s",
+		gameSnapshotURL:       nbaAPIURL + "/stats2/game/snapshot.json?countryCode=TW&locale=%s&gameId=%s",
+		conferenceStandingURL: nbaAPIURL + "/stats2/season/conferencestanding.json?locale=%s",
+		bracketURL:            nbaAPIURL + "/stats2/playoff/bracket.json?locale=%s",
 	}
-	body, err := ioutil.ReadAll(resp.Body)
+}
+
+// GetNBAGameByDate return the games on the day of date in the zone of date
+func (s *HTTPDataSource) GetNBAGameByDate(date *time.Time, locale string) (*GameInfo, error) {
+	nbaquertURL := fmt.Sprintf(s.scoresURL, locale, url.QueryEscape(apiTimezone(*date))) + fmt.Sprintf("&gameDate=%s", date.Format(NBA_API_TIME_FORMAT))
+	return s.getNBAGame(localeKey(scoresKey(date), locale), nbaquertURL)
+}
+
+// GetNBAGameToday return the games of today in the default zone
+func (s *HTTPDataSource) GetNBAGameToday(locale string) (*GameInfo, error) {
+	nbaquertURL := fmt.Sprintf(s.scoresURL, locale, url.QueryEscape(apiTimezone(time.Now().In(_localZone))))
+	return s.getNBAGame(localeKey(scoresTodayKey, locale), nbaquertURL)
+}
+
+func (s *HTTPDataSource) getNBAGame(key string, url string) (*GameInfo, error) {
+	body, err := s.get(key, url)
 	if err != nil {
-		log.Printf("error: ReadAll error %v", err)
 		return nil, err
 	}
-	data := GameInfo{}
-	json.Unmarshal(body, &data)
-	return &data, err
+	return decodeGameInfo(body)
 }
 
-func GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error) {
-	url := fmt.Sprintf(nbaAPIGameSnapshotURL, locale, id)
-	resp, err := http.Get(url)
+func (s *HTTPDataSource) GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error) {
+	body, err := s.get(snapshotKey(id, locale), fmt.Sprintf(s.gameSnapshotURL, locale, id))
 	if err != nil {
-		log.Printf("error: get error %v", err)
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		log.Printf("error: get fail %s", resp.Body)
-		return nil, fmt.Errorf("status code error %v", resp.Body)
+	return decodeGamePlayerInfo(body)
+}
+
+func (s *HTTPDataSource) GetNBAConferenceStanding(locale string) (*ConferenceStanding, error) {
+	body, err := s.get(localeKey(standingKey, locale), fmt.Sprintf(s.conferenceStandingURL, locale))
+	if err != nil {
+		return nil, err
 	}
-	body, err := ioutil.ReadAll(resp.Body)
+	return decodeConferenceStanding(body)
+}
+
+func (s *HTTPDataSource) GetNBAPlayoffs(locale string) (*BracketInfo, error) {
+	body, err := s.get(localeKey(bracketKey, locale), fmt.Sprintf(s.bracketURL, locale))
 	if err != nil {
-		log.Printf("error: ReadAll error %v", err)
 		return nil, err
 	}
-	data := GamePlayerInfo{}
-	json.Unmarshal(body, &data)
-	return &data, err
+	return decodeBracketInfo(body)
 }
 
-func GetNBAConferenceStanding() (*ConferenceStanding, error) {
-	resp, err := http.Get(nbaConferenceStandingAPI)
+func (s *HTTPDataSource) get(key string, url string) ([]byte, error) {
+	body, err := s.client.Get(url)
 	if err != nil {
-		log.Printf("error: get error %v", err)
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		log.Printf("error: get fail %s", resp.Body)
-		return nil, fmt.Errorf("status code error %v", resp.Body)
+	if s.recorder != nil {
+		if err := s.recorder.Save(key, time.Now(), body); err != nil {
+			log.Printf("error: record %s error %v", key, err)
+		}
 	}
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("error: ReadAll error %v", err)
+	return body, nil
+}
+
+func decodeGameInfo(body []byte) (*GameInfo, error) {
+	data := GameInfo{}
+	if err := decodePayload("decode scores", body, &data, &data.Error); err != nil {
 		return nil, err
 	}
-	data := ConferenceStanding{}
-	json.Unmarshal(body, &data)
-	return &data, err
+	return &data, nil
 }
 
-func GetNBAPlayoffs() (*BracketInfo, error) {
-	resp, err := http.Get(nbaBracketURL)
-	if err != nil {
-		log.Printf("error: get error %v", err)
+func decodeGamePlayerInfo(body []byte) (*GamePlayerInfo, error) {
+	data := GamePlayerInfo{}
+	if err := decodePayload("decode snapshot", body, &data, &data.Error); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		log.Printf("error: get fail %s", resp.Body)
-		return nil, fmt.Errorf("status code error %v", resp.Body)
+	if data.Payload.GameProfile.GameID == "" {
+		return nil, newSourceError(ErrNotFound, "decode snapshot", fmt.Errorf("empty game profile"))
 	}
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("error: ReadAll error %v", err)
+	return &data, nil
+}
+
+func decodeConferenceStanding(body []byte) (*ConferenceStanding, error) {
+	data := ConferenceStanding{}
+	if err := decodePayload("decode standing", body, &data, &data.Error); err != nil {
 		return nil, err
 	}
+	return &data, nil
+}
+
+func decodeBracketInfo(body []byte) (*BracketInfo, error) {
 	data := BracketInfo{}
-	json.Unmarshal(body, &data)
-	return &data, err
+	if err := decodePayload("decode bracket", body, &data, &data.Error); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// decodePayload unmarshal body into v and check the error block of the payload
+func decodePayload(op string, body []byte, v interface{}, payloadErr *PayloadError) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return newSourceError(ErrDecode, op, err)
+	}
+	if err := payloadErr.Err(); err != nil {
+		return newSourceError(ErrUpstream, op, err)
+	}
+	return nil
 }
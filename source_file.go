@@ -0,0 +1,93 @@
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	fakeGameDataFile           = "fake_game_data.json"
+	fakeGamePlayerDataFile     = "fake_game_player_data.json"
+	fakeConferenceStandingFile = "fake_conference_standing_data.json"
+	fakeBracketDataFile        = "fake_bracket_data.json"
+)
+
+// FileDataSource serve fixture files from a local directory, so the bot can
+// run without upstream access.
+//
+// A date or game specific fixture (fake_game_data_2018-02-03.json,
+// fake_game_player_data_0021700784.json) is preferred when it exists,
+// otherwise the default fixture is returned. The fixtures are zh_TW, the
+// locale and the zone of the requests are ignored.
+type FileDataSource struct {
+	dir string
+}
+
+func NewFileDataSource(dir string) *FileDataSource {
+	return &FileDataSource{dir: dir}
+}
+
+func (s *FileDataSource) GetNBAGameToday(locale string) (*GameInfo, error) {
+	return s.getNBAGame(fakeGameDataFile)
+}
+
+func (s *FileDataSource) GetNBAGameByDate(date *time.Time, locale string) (*GameInfo, error) {
+	return s.getNBAGame(s.fixtureName(fakeGameDataFile, date.Format(NBA_API_TIME_FORMAT)))
+}
+
+func (s *FileDataSource) getNBAGame(name string) (*GameInfo, error) {
+	body, err := s.read(name)
+	if err != nil {
+		return nil, err
+	}
+	return decodeGameInfo(body)
+}
+
+func (s *FileDataSource) GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error) {
+	body, err := s.read(s.fixtureName(fakeGamePlayerDataFile, id))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGamePlayerInfo(body)
+}
+
+func (s *FileDataSource) GetNBAConferenceStanding(locale string) (*ConferenceStanding, error) {
+	body, err := s.read(fakeConferenceStandingFile)
+	if err != nil {
+		return nil, err
+	}
+	return decodeConferenceStanding(body)
+}
+
+func (s *FileDataSource) GetNBAPlayoffs(locale string) (*BracketInfo, error) {
+	body, err := s.read(fakeBracketDataFile)
+	if err != nil {
+		return nil, err
+	}
+	return decodeBracketInfo(body)
+}
+
+// fixtureName return "<base>_<suffix>.json" if the file exists, otherwise base
+func (s *FileDataSource) fixtureName(base string, suffix string) string {
+	ext := filepath.Ext(base)
+	name := base[:len(base)-len(ext)] + "_" + suffix + ext
+	if _, err := os.Stat(filepath.Join(s.dir, name)); err == nil {
+		return name
+	}
+	return base
+}
+
+func (s *FileDataSource) read(name string) ([]byte, error) {
+	body, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		log.Printf("error: read fixture error %v", err)
+		if os.IsNotExist(err) {
+			return nil, newSourceError(ErrNotFound, "read "+name, err)
+		}
+		return nil, newSourceError(ErrSourceUnavailable, "read "+name, err)
+	}
+	return body, nil
+}
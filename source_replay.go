@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// ReplayDataSource serve a directory written by the Recorder as if it was
+// live: the capture clock starts at the first recorded response and runs
+// speed times faster than the wall clock, each request get the latest
+// response recorded before the capture clock.
+type ReplayDataSource struct {
+	dir          string
+	speed        float64
+	startedAt    time.Time
+	captureStart time.Time
+
+	sync.Mutex
+	files map[string][]recordedFile
+}
+
+func NewReplayDataSource(dir string, speed float64) (*ReplayDataSource, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("config replay_dir empty")
+	}
+	s := &ReplayDataSource{
+		dir:       dir,
+		speed:     speed,
+		startedAt: time.Now(),
+		files:     map[string][]recordedFile{},
+	}
+	// the scoreboard is polled for the whole capture, its first response
+	// marks the capture start
+	files, err := s.list(scoresTodayKey)
+	if err != nil || len(files) == 0 {
+		return nil, fmt.Errorf("replay: no %s recorded in %s", scoresTodayKey, dir)
+	}
+	s.captureStart = files[0].at
+	return s, nil
+}
+
+// Now return the capture clock
+func (s *ReplayDataSource) Now() time.Time {
+	elapsed := time.Since(s.startedAt)
+	return s.captureStart.Add(time.Duration(float64(elapsed) * s.speed))
+}
+
+func (s *ReplayDataSource) GetNBAGameToday(locale string) (*GameInfo, error) {
+	body, err := s.read(localeKey(scoresTodayKey, locale))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGameInfo(body)
+}
+
+func (s *ReplayDataSource) GetNBAGameByDate(date *time.Time, locale string) (*GameInfo, error) {
+	body, err := s.read(localeKey(scoresKey(date), locale))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGameInfo(body)
+}
+
+func (s *ReplayDataSource) GetNBAGamePlayerByGameID(id string, locale string) (*GamePlayerInfo, error) {
+	body, err := s.read(snapshotKey(id, locale))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGamePlayerInfo(body)
+}
+
+func (s *ReplayDataSource) GetNBAConferenceStanding(locale string) (*ConferenceStanding, error) {
+	body, err := s.read(localeKey(standingKey, locale))
+	if err != nil {
+		return nil, err
+	}
+	return decodeConferenceStanding(body)
+}
+
+func (s *ReplayDataSource) GetNBAPlayoffs(locale string) (*BracketInfo, error) {
+	body, err := s.read(localeKey(bracketKey, locale))
+	if err != nil {
+		return nil, err
+	}
+	return decodeBracketInfo(body)
+}
+
+// read return the latest response of key recorded before the capture clock,
+// or the first one if the clock has not reached it yet
+func (s *ReplayDataSource) read(key string) ([]byte, error) {
+	files, err := s.list(key)
+	if err != nil || len(files) == 0 {
+		return nil, newSourceError(ErrNotFound, "replay "+key, err)
+	}
+	now := s.Now()
+	file := files[0]
+	for _, f := range files[1:] {
+		if f.at.After(now) {
+			break
+		}
+		file = f
+	}
+	body, err := ioutil.ReadFile(file.path)
+	if err != nil {
+		return nil, newSourceError(ErrSourceUnavailable, "replay "+key, err)
+	}
+	return body, nil
+}
+
+// list cache the directory listing of key, the capture does not change
+// while replaying
+func (s *ReplayDataSource) list(key string) ([]recordedFile, error) {
+	s.Lock()
+	defer s.Unlock()
+	if files, ok := s.files[key]; ok {
+		return files, nil
+	}
+	files, err := listRecordedFiles(s.dir, key)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	s.files[key] = files
+	return files, nil
+}
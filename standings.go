@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	DivisionStandingStr      = "分組戰績"
+	DivisionQueryStr         = "請選擇分組"
+	DivisionNotFoundStr      = "找不到分組「%s」"
+	EasternDetailStandingStr = "東區詳細戰績"
+	WesternDetailStandingStr = "西區詳細戰績"
+	CmdEasternDetailStanding = _cmd_prefix + EasternDetailStandingStr
+	CmdWesternDetailStanding = _cmd_prefix + WesternDetailStandingStr
+	ClinchedLegendStr        = "z 聯盟第一 y 分組第一 x 季後賽 o 淘汰"
+	DefaultStandingView      = "basic"
+	StandingViewDetail       = "detail"
+)
+
+// Division is a division of a conference. Name is the division name of the
+// standing data.
+type Division struct {
+	Key        string
+	Name       string
+	Conference string
+}
+
+var Divisions = []Division{
+	{Key: "Atlantic", Name: "大西洋組", Conference: "Eastern"},
+	{Key: "Central", Name: "中央組", Conference: "Eastern"},
+	{Key: "Southeast", Name: "東南組", Conference: "Eastern"},
+	{Key: "Northwest", Name: "西北組", Conference: "Western"},
+	{Key: "Pacific", Name: "太平洋組", Conference: "Western"},
+	{Key: "Southwest", Name: "西南組", Conference: "Western"},
+}
+
+// findDivision return the division of a key or name, case insensitive
+func findDivision(text string) *Division {
+	text = strings.TrimSpace(text)
+	for i, division := range Divisions {
+		if strings.EqualFold(division.Key, text) || division.Name == text || division.Name == text+"組" {
+			return &Divisions[i]
+		}
+	}
+	return nil
+}
+
+// standingRow is a team of a standing table, ranked within the conference or
+// the division drawn
+type standingRow struct {
+	Rank        int
+	GamesBehind float64
+	Team        StandingTeam
+}
+
+// StandingColumn is a column of the standing images
+type StandingColumn struct {
+	Key   string
+	Name  string
+	Value func(row standingRow) string
+}
+
+func winLoss(win int, loss int) string {
+	return fmt.Sprintf("%2d - %2d", win, loss)
+}
+
+var StandingColumns = []StandingColumn{
+	{Key: "RANK", Name: "", Value: func(row standingRow) string { return fmt.Sprintf("%02d", row.Rank) }},
+	{Key: "TEAM", Name: "a7", Value: func(row standingRow) string { return row.Team.Profile.Name }},
+	{Key: "CLINCH", Name: "", Value: func(row standingRow) string { return row.Team.Standings.Clinched }},
+	{Key: "WL", Name: "勝負", Value: func(row standingRow) string {
+		return winLoss(row.Team.Standings.Wins, row.Team.Standings.Losses)
+	}},
+	{Key: "PCT", Name: "勝率", Value: func(row standingRow) string {
+		return fmt.Sprintf("%.3f", row.Team.Standings.WinPct)
+	}},
+	{Key: "GB", Name: "勝差", Value: func(row standingRow) string {
+		return fmt.Sprintf("%.1f", row.GamesBehind)
+	}},
+	{Key: "HOME", Name: "主場", Value: func(row standingRow) string {
+		return winLoss(row.Team.Standings.HomeWin, row.Team.Standings.HomeLoss)
+	}},
+	{Key: "ROAD", Name: "客場", Value: func(row standingRow) string {
+		return winLoss(row.Team.Standings.RoadWin, row.Team.Standings.RoadLoss)
+	}},
+	{Key: "CONF", Name: "同區", Value: func(row standingRow) string {
+		return winLoss(row.Team.Standings.ConfWin, row.Team.Standings.ConfLoss)
+	}},
+	{Key: "DIV", Name: "同組", Value: func(row standingRow) string {
+		return winLoss(row.Team.Standings.DivWin, row.Team.Standings.DivLoss)
+	}},
+	{Key: "L10", Name: "近10場", Value: func(row standingRow) string { return row.Team.Standings.Last10 }},
+	{Key: "STRK", Name: "連勝敗", Value: func(row standingRow) string { return row.Team.Standings.Streak }},
+	{Key: "PF", Name: "得分", Value: func(row standingRow) string {
+		return fmt.Sprintf("%.1f", row.Team.Standings.PointsFor)
+	}},
+	{Key: "PA", Name: "失分", Value: func(row standingRow) string {
+		return fmt.Sprintf("%.1f", row.Team.Standings.PointsAgainst)
+	}},
+	{Key: "DIFF", Name: "分差", Value: func(row standingRow) string {
+		s := row.Team.Standings
+		return fmt.Sprintf("%+.1f", s.PointsFor-s.PointsAgainst)
+	}},
+}
+
+// StandingViews are the column keys of each standing view, selected with
+// "?view=". "?cols=" draw any columns instead.
+var StandingViews = map[string][]string{
+	DefaultStandingView: {"RANK", "TEAM", "WL", "GB"},
+	StandingViewDetail:  {"RANK", "TEAM", "CLINCH", "WL", "PCT", "GB", "HOME", "ROAD", "L10", "STRK", "DIFF"},
+}
+
+// standingColumns return the columns of view, or of cols, a comma separated
+// list of keys, when it is not empty
+func standingColumns(view string, cols string) ([]StandingColumn, error) {
+	keys := []string{}
+	for _, key := range strings.Split(cols, ",") {
+		if key = strings.ToUpper(strings.TrimSpace(key)); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		if view == "" {
+			view = DefaultStandingView
+		}
+		var ok bool
+		if keys, ok = StandingViews[view]; !ok {
+			return nil, fmt.Errorf("unknown view %q", view)
+		}
+	}
+	columns := []StandingColumn{}
+	for _, key := range keys {
+		found := false
+		for _, col := range StandingColumns {
+			if col.Key == key {
+				columns = append(columns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q", key)
+		}
+	}
+	return columns, nil
+}
+
+// conferenceStandingRows return the teams of conference ordered by their
+// conference rank
+func conferenceStandingRows(data *ConferenceStanding, conference string) []standingRow {
+	rows := []standingRow{}
+	for _, group := range data.Payload.StandingGroups {
+		if strings.ToLower(group.Conference) != strings.ToLower(conference) {
+			continue
+		}
+		for _, team := range group.Teams {
+			rows = append(rows, standingRow{
+				Rank:        team.Standings.ConfRank,
+				GamesBehind: team.Standings.ConfGamesBehind,
+				Team:        team,
+			})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[j].Rank > rows[i].Rank
+	})
+	return rows
+}
+
+// divisionStandingRows return the teams of division ordered by their
+// division rank. The division of the team is its Name in zh_TW and its Key
+// in en.
+func divisionStandingRows(data *ConferenceStanding, division *Division) []standingRow {
+	rows := []standingRow{}
+	for _, group := range data.Payload.StandingGroups {
+		for _, team := range group.Teams {
+			if team.Profile.Division != division.Name && !strings.EqualFold(team.Profile.Division, division.Key) {
+				continue
+			}
+			rows = append(rows, standingRow{
+				Rank:        team.Standings.DivRank,
+				GamesBehind: team.Standings.DivGameBehind,
+				Team:        team,
+			})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[j].Rank > rows[i].Rank
+	})
+	return rows
+}
+
+func (app *NBABotClient) ParseStandingToImgMessage(c *gin.Context, rows []standingRow, columns []StandingColumn, title string, locale string) {
+	header := []string{}
+	clinched := false
+	for _, col := range columns {
+		header = append(header, T(locale, col.Name))
+		clinched = clinched || col.Key == "CLINCH"
+	}
+	messageArr := [][]string{header}
+	for _, row := range rows {
+		mArr := []string{}
+		for _, col := range columns {
+			mArr = append(mArr, col.Value(row))
+		}
+		messageArr = append(messageArr, mArr)
+	}
+	opts := []*TextToImageOpt{
+		{
+			TextData: messageArr,
+		},
+	}
+	if clinched {
+		opts = append(opts, &TextToImageOpt{SubTitle: T(locale, ClinchedLegendStr)})
+	}
+
+	convertTextArrToTableImage(c, opts, title)
+}
+
+func (app *NBABotClient) getStanding(c *gin.Context, data *ConferenceStanding, conference string, locale string) {
+	columns, err := standingColumns(c.Query("view"), c.Query("cols"))
+	if err != nil {
+		log.Printf("getStanding %v", err)
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	if division := findDivision(conference); division != nil {
+		app.ParseStandingToImgMessage(c, divisionStandingRows(data, division), columns, Tf(locale, "%s戰績", T(locale, division.Name)), locale)
+		app.CounterIncs("分組戰績圖片")
+		return
+	}
+	title := WesternConferenceStandingStr
+	if conference == "a8" || strings.EqualFold(conference, "Eastern") {
+		title = EasternConferenceStandingStr
+	}
+	app.ParseStandingToImgMessage(c, conferenceStandingRows(data, conference), columns, T(locale, title), locale)
+	app.CounterIncs("戰績圖片")
+}
+
+// cmdDivisionStanding reply the standing image of the division named by the
+// query, or the divisions to choose from
+func (app *NBABotClient) cmdDivisionStanding(ctx *CommandContext) (linebot.SendingMessage, error) {
+	if ctx.Args.Query == "" {
+		items := []*linebot.QuickReplyButton{}
+		for _, division := range Divisions {
+			items = append(items, linebot.NewQuickReplyButton("", commandPostbackAction(T(ctx.Locale, division.Name), DivisionStandingStr+" "+division.Name)))
+		}
+		return linebot.NewTextMessage(T(ctx.Locale, DivisionQueryStr)).WithQuickReplies(linebot.NewQuickReplyItems(items...)), nil
+	}
+	division := findDivision(ctx.Args.Query)
+	if division == nil {
+		return linebot.NewTextMessage(Tf(ctx.Locale, DivisionNotFoundStr, ctx.Args.Query)), nil
+	}
+	return app.imageMessage("/standing/"+division.Key, ctx.Settings), nil
+}
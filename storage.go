@@ -20,3 +20,63 @@ func CreateMessage(m Message) (Message, error) {
 
 	return m, nil
 }
+
+// CreateSubscription create Subscription, following a team twice is a no-op
+func CreateSubscription(s Subscription) (Subscription, error) {
+	if err := repo.Where(Subscription{ChatID: s.ChatID, TeamID: s.TeamID}).FirstOrCreate(&s).Error; err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// DeleteSubscription delete the Subscription of chatID to teamID
+func DeleteSubscription(chatID, teamID string) (bool, error) {
+	db := repo.Where("chat_id = ? AND team_id = ?", chatID, teamID).Delete(Subscription{})
+	if err := db.Error; err != nil {
+		return false, err
+	}
+	return db.RowsAffected > 0, nil
+}
+
+// ListSubscriptionsByChat list the Subscriptions of a chat
+func ListSubscriptionsByChat(chatID string) (subscriptions []Subscription, err error) {
+	if err = repo.Where("chat_id = ?", chatID).Order("id").Find(&subscriptions).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return
+	}
+	return subscriptions, nil
+}
+
+// ListSubscriptionsByTeams list the Subscriptions to any of teamIDs
+func ListSubscriptionsByTeams(teamIDs ...string) (subscriptions []Subscription, err error) {
+	if err = repo.Where("team_id IN (?)", teamIDs).Find(&subscriptions).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return
+	}
+	return subscriptions, nil
+}
+
+// GetChatSettings return the ChatSettings of chatID, empty settings if the
+// chat never saved any
+func GetChatSettings(chatID string) (ChatSettings, error) {
+	settings := ChatSettings{}
+	if err := repo.Where("chat_id = ?", chatID).First(&settings).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ChatSettings{ChatID: chatID}, nil
+		}
+		return ChatSettings{ChatID: chatID}, err
+	}
+	return settings, nil
+}
+
+// SaveChatSettings create or update the ChatSettings of s.ChatID
+func SaveChatSettings(s ChatSettings) (ChatSettings, error) {
+	settings := ChatSettings{}
+	if err := repo.Where(ChatSettings{ChatID: s.ChatID}).FirstOrCreate(&settings).Error; err != nil {
+		return s, err
+	}
+	s.ID = settings.ID
+	if err := repo.Save(&s).Error; err != nil {
+		return s, err
+	}
+	return s, nil
+}
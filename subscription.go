@@ -0,0 +1,196 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	FollowTeamStr     = "追蹤"
+	UnfollowTeamStr   = "取消追蹤"
+	FollowListStr     = "追蹤清單"
+	FollowUsageStr    = "請輸入球隊名稱，例如：追蹤 湖人"
+	TeamNotFoundStr   = "找不到球隊：%s"
+	FollowedStr       = "已追蹤 %s，開賽、中場及比賽結束時會通知"
+	UnfollowedStr     = "已取消追蹤 %s"
+	NotFollowingStr   = "沒有追蹤 %s"
+	EmptyFollowStr    = "尚未追蹤任何球隊"
+	FollowingTeamsStr = "追蹤中的球隊：%s"
+)
+
+// chatIDOf return the ID replies and pushes for source go to
+func chatIDOf(source *linebot.EventSource) string {
+	switch {
+	case source.GroupID != "":
+		return source.GroupID
+	case source.RoomID != "":
+		return source.RoomID
+	default:
+		return source.UserID
+	}
+}
+
+func (app *NBABotClient) cmdFollowList(ctx *CommandContext) (linebot.SendingMessage, error) {
+	subscriptions, err := ListSubscriptionsByChat(chatIDOf(ctx.Source))
+	if err != nil {
+		log.Printf("ListSubscriptionsByChat error: %v", err)
+		return linebot.NewTextMessage(T(ctx.Locale, SourceErrorStr)), nil
+	}
+	if len(subscriptions) == 0 {
+		return linebot.NewTextMessage(T(ctx.Locale, EmptyFollowStr)), nil
+	}
+	names := []string{}
+	for _, s := range subscriptions {
+		names = append(names, s.TeamName)
+	}
+	return linebot.NewTextMessage(Tf(ctx.Locale, FollowingTeamsStr, strings.Join(names, T(ctx.Locale, "、")))), nil
+}
+
+func (app *NBABotClient) cmdFollow(ctx *CommandContext) (linebot.SendingMessage, error) {
+	teamID, teamName, reply := app.findFollowTeam(ctx.Args.Query, ctx.Locale)
+	if reply != nil {
+		return reply, nil
+	}
+	if _, err := CreateSubscription(Subscription{
+		ChatID:   chatIDOf(ctx.Source),
+		TeamID:   teamID,
+		TeamName: teamName,
+	}); err != nil {
+		log.Printf("CreateSubscription error: %v", err)
+		return linebot.NewTextMessage(T(ctx.Locale, SourceErrorStr)), nil
+	}
+	return linebot.NewTextMessage(Tf(ctx.Locale, FollowedStr, teamName)), nil
+}
+
+func (app *NBABotClient) cmdUnfollow(ctx *CommandContext) (linebot.SendingMessage, error) {
+	teamID, teamName, reply := app.findFollowTeam(ctx.Args.Query, ctx.Locale)
+	if reply != nil {
+		return reply, nil
+	}
+	deleted, err := DeleteSubscription(chatIDOf(ctx.Source), teamID)
+	if err != nil {
+		log.Printf("DeleteSubscription error: %v", err)
+		return linebot.NewTextMessage(T(ctx.Locale, SourceErrorStr)), nil
+	}
+	if !deleted {
+		return linebot.NewTextMessage(Tf(ctx.Locale, NotFollowingStr, teamName)), nil
+	}
+	return linebot.NewTextMessage(Tf(ctx.Locale, UnfollowedStr, teamName)), nil
+}
+
+// findFollowTeam resolve query to a team, or return the reply explaining why
+// it could not
+func (app *NBABotClient) findFollowTeam(query string, locale string) (string, string, linebot.SendingMessage) {
+	if query == "" {
+		return "", "", linebot.NewTextMessage(T(locale, FollowUsageStr))
+	}
+	team, rest, err := app.teams.Resolve(query)
+	if err != nil {
+		log.Printf("findFollowTeam Resolve error: %v", err)
+		return "", "", linebot.NewTextMessage(sourceErrorText(err, locale))
+	}
+	if team == nil || rest != "" {
+		return "", "", linebot.NewTextMessage(Tf(locale, TeamNotFoundStr, query))
+	}
+	return team.ID, team.Name, nil
+}
+
+// NotifyGameEvent push tip-off, halftime and final events to the chats
+// following either team of the game
+func (app *NBABotClient) NotifyGameEvent(event GameEvent) {
+	var format string
+	switch {
+	case event.Type == GameEventStarted:
+		format = "%s vs %s 開賽了！"
+	case event.Type == GameEventPeriodEnded && event.Period == 2:
+		format = "%s vs %s 中場休息"
+	case event.Type == GameEventFinal:
+		format = "%s vs %s 比賽結束"
+	default:
+		return
+	}
+	// the poller should not wait for LINE
+	go app.pushGameEvent(event, format)
+}
+
+// gameEventMuted tell whether the chat turned off the notifications of event
+func gameEventMuted(event GameEvent, settings ChatSettings) bool {
+	switch event.Type {
+	case GameEventStarted:
+		return settings.MuteStart
+	case GameEventPeriodEnded:
+		return settings.MuteHalftime
+	case GameEventFinal:
+		return settings.MuteFinal
+	}
+	return false
+}
+
+// gameEventMessages return the title and the score of event in locale and
+// zone. The poller snapshot is in DefaultLocale, other locales fetch their
+// own.
+func (app *NBABotClient) gameEventMessages(event GameEvent, format string, locale string, zone *time.Location) []linebot.SendingMessage {
+	game := event.Game
+	if locale != DefaultLocale {
+		pInfo, err := app.source.GetNBAGamePlayerByGameID(game.Payload.GameProfile.GameID, locale)
+		if err != nil {
+			log.Printf("gameEventMessages GetNBAGamePlayerByGameID %s error: %v", locale, err)
+		} else {
+			game = pInfo
+		}
+	}
+	title := Tf(locale, format, game.Payload.HomeTeam.Profile.Name, game.Payload.AwayTeam.Profile.Name)
+	return []linebot.SendingMessage{
+		linebot.NewTextMessage(title),
+		app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
+			data:     parseGamePlayerInfoToGameScoreInfo(game),
+			showList: false,
+			locale:   locale,
+			zone:     zone,
+		}),
+	}
+}
+
+func (app *NBABotClient) pushGameEvent(event GameEvent, format string) {
+	profile := event.Game.Payload.GameProfile
+	subscriptions, err := ListSubscriptionsByTeams(profile.HomeTeamID, profile.AwayTeamID)
+	if err != nil {
+		log.Printf("ListSubscriptionsByTeams error: %v", err)
+		return
+	}
+	messages := map[string][]linebot.SendingMessage{}
+	pushed := map[string]bool{}
+	for _, s := range subscriptions {
+		if pushed[s.ChatID] {
+			continue
+		}
+		pushed[s.ChatID] = true
+		settings := app.chatSettings(s.ChatID)
+		if gameEventMuted(event, settings) {
+			continue
+		}
+		locale, zone := settings.ReplyLocale(), settings.Zone()
+		key := locale + "/" + zone.String()
+		if _, ok := messages[key]; !ok {
+			messages[key] = app.gameEventMessages(event, format, locale, zone)
+		}
+		chatMessages := messages[key]
+		if settings.TextOnly {
+			chatMessages = []linebot.SendingMessage{}
+			for _, m := range messages[key] {
+				chatMessages = append(chatMessages, textOnlyMessage(m))
+			}
+		}
+		if _, err := app.bot.PushMessage(
+			s.ChatID,
+			chatMessages...,
+		).Do(); err != nil {
+			log.Printf("push %s to %s error: %v", event, s.ChatID, err)
+			continue
+		}
+		app.CounterIncs("推播通知")
+	}
+}
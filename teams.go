@@ -0,0 +1,266 @@
+package main
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/line/line-bot-sdk-go/linebot"
+)
+
+var (
+	TeamNoGameStr = "%s 近期沒有賽事"
+	TeamQueryStr  = "球隊查詢"
+)
+
+// DefaultTeamAliases are the nicknames not found in the team profiles, keyed
+// by alias to a team abbreviation. The team_aliases config adds to them.
+var DefaultTeamAliases = map[string]string{
+	"小牛":     "DAL",
+	"綠衫軍":    "BOS",
+	"塞爾提克":   "BOS",
+	"快船":     "LAC",
+	"紫金":     "LAL",
+	"七六人":    "PHI",
+	"SIXERS": "PHI",
+	"CAVS":   "CLE",
+	"MAVS":   "DAL",
+	"WOLVES": "MIN",
+	"DUBS":   "GSW",
+}
+
+// teamScheduleSearchDays is how many days ahead a team's next game is looked for
+const teamScheduleSearchDays = 10
+
+// teamQuerySuffixes are the words allowed after a team name, e.g. 勇士明天,
+// keyed to the day offset the game search starts from. Any other text after
+// a team name is not a team query.
+var teamQuerySuffixes = map[string]int{
+	"":         0,
+	"今天":       0,
+	"今日":       0,
+	"TODAY":    0,
+	"下一場":      0,
+	"NEXT":     0,
+	"明天":       1,
+	"明日":       1,
+	"TOMORROW": 1,
+}
+
+type Team struct {
+	ID     string
+	Name   string
+	NameEn string
+	City   string
+	CityEn string
+	Abbr   string
+	Code   string
+}
+
+// TeamDirectory resolve free text to a team, using the team profiles of the
+// standings and an alias table.
+type TeamDirectory struct {
+	source  DataSource
+	aliases map[string]string
+
+	sync.Mutex
+	// standings the index was built from, the cached source returns the
+	// same pointer until it expires
+	standings *ConferenceStanding
+	teams     map[string]*Team
+	keys      []string
+}
+
+func NewTeamDirectory(source DataSource, aliases map[string]string) *TeamDirectory {
+	merged := map[string]string{}
+	for alias, team := range DefaultTeamAliases {
+		merged[normalizeCommandText(alias)] = team
+	}
+	for alias, team := range aliases {
+		merged[normalizeCommandText(alias)] = team
+	}
+	return &TeamDirectory{
+		source:  source,
+		aliases: merged,
+	}
+}
+
+// Resolve find the team text starts with and return it with the rest of the
+// text, or a nil team if none matches
+func (d *TeamDirectory) Resolve(text string) (*Team, string, error) {
+	teams, keys, err := d.index()
+	if err != nil {
+		return nil, "", err
+	}
+	text = normalizeCommandText(text)
+	if team, ok := teams[text]; ok {
+		return team, "", nil
+	}
+	for _, key := range keys {
+		if !strings.HasPrefix(text, key) {
+			continue
+		}
+		rest := text[len(key):]
+		// "MINE" is not "MIN", "勇士今天" is 勇士
+		if r, _ := utf8.DecodeRuneInString(rest); r < utf8.RuneSelf && isASCIIAlnum(byte(r)) {
+			continue
+		}
+		return teams[key], strings.TrimSpace(rest), nil
+	}
+	return nil, text, nil
+}
+
+func (d *TeamDirectory) index() (map[string]*Team, []string, error) {
+	data, err := d.source.GetNBAConferenceStanding(DefaultLocale)
+	if err != nil {
+		return nil, nil, err
+	}
+	d.Lock()
+	defer d.Unlock()
+	if data == d.standings {
+		return d.teams, d.keys, nil
+	}
+
+	teams := map[string]*Team{}
+	byAbbr := map[string]*Team{}
+	// names shared by two teams, e.g. 洛杉磯, can not resolve
+	ambiguous := map[string]bool{}
+	add := func(key string, team *Team) {
+		key = normalizeCommandText(key)
+		if key == "" || ambiguous[key] {
+			return
+		}
+		if other, ok := teams[key]; ok && other.ID != team.ID {
+			ambiguous[key] = true
+			delete(teams, key)
+			return
+		}
+		teams[key] = team
+	}
+	for _, group := range data.Payload.StandingGroups {
+		for _, t := range group.Teams {
+			p := t.Profile
+			team := &Team{
+				ID:     p.ID,
+				Name:   p.Name,
+				NameEn: p.NameEn,
+				City:   p.City,
+				CityEn: p.CityEn,
+				Abbr:   p.Abbr,
+				Code:   p.Code,
+			}
+			byAbbr[normalizeCommandText(p.Abbr)] = team
+			for _, key := range []string{p.ID, p.Name, p.NameEn, p.City, p.CityEn, p.Abbr, p.Code, p.DisplayAbbr, p.CityEn + " " + p.NameEn} {
+				add(key, team)
+			}
+		}
+	}
+	// aliases win over ambiguous profile names
+	for alias, abbr := range d.aliases {
+		team, ok := byAbbr[normalizeCommandText(abbr)]
+		if !ok {
+			if team, ok = teams[normalizeCommandText(abbr)]; !ok {
+				log.Printf("team alias %s: unknown team %s", alias, abbr)
+				continue
+			}
+		}
+		teams[alias] = team
+	}
+
+	keys := make([]string, 0, len(teams))
+	for key := range teams {
+		keys = append(keys, key)
+	}
+	// longest first, so "LA LAKERS" wins over "LA"
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	d.standings = data
+	d.teams = teams
+	d.keys = keys
+	return teams, keys, nil
+}
+
+func isASCIIAlnum(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
+
+// cmdTeam reply the game of the team the text names, today's or the next
+// scheduled one. Texts naming no team get no reply.
+func (app *NBABotClient) cmdTeam(ctx *CommandContext) (linebot.SendingMessage, error) {
+	team, rest, err := app.teams.Resolve(ctx.Text)
+	if err != nil {
+		// most texts are not team queries, do not answer them with an error
+		log.Printf("cmdTeam Resolve error: %v", err)
+		return nil, nil
+	}
+	if team == nil {
+		return nil, nil
+	}
+	offset, ok := teamQuerySuffixes[rest]
+	if !ok {
+		return nil, nil
+	}
+	app.CounterIncs(TeamQueryStr)
+	games, err := app.findTeamGames(team.ID, offset, ctx.Locale, ctx.Settings.Zone())
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return linebot.NewTextMessage(Tf(ctx.Locale, TeamNoGameStr, team.Name)), nil
+	}
+	return app.ParseGameScoreInfoToMessage(&ParseGameScoreOpt{
+		data:     games,
+		showList: false,
+		locale:   ctx.Locale,
+		zone:     ctx.Settings.Zone(),
+	}), nil
+}
+
+// findTeamGames return the games of teamID offset days from today, or on the
+// next day it plays, the days starting at midnight in zone, fetched in locale
+func (app *NBABotClient) findTeamGames(teamID string, offset int, locale string, zone *time.Location) ([]*GameScoreInfo, error) {
+	today := time.Now().In(zone).AddDate(0, 0, offset)
+	data, err := app.gamesOn(today, locale)
+	if err != nil {
+		return nil, err
+	}
+	date := today
+	for day := 0; ; day++ {
+		if games := teamGames(data, teamID); len(games) > 0 {
+			return games, nil
+		}
+		if day == teamScheduleSearchDays {
+			return nil, nil
+		}
+		// skip the days without any game
+		next := date.AddDate(0, 0, 1)
+		if nextAvailable, ok := millisTime(data.Payload.NextAvailableDateMillis); ok && nextAvailable.In(zone).After(next) {
+			next = nextAvailable.In(zone)
+		}
+		if next.Sub(today) > teamScheduleSearchDays*24*time.Hour {
+			return nil, nil
+		}
+		date = next
+		if data, err = app.source.GetNBAGameByDate(&date, locale); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func teamGames(data *GameInfo, teamID string) []*GameScoreInfo {
+	games := []*GameScoreInfo{}
+	for i, game := range parseGameInfoToGameScoreInfo(data) {
+		profile := data.Payload.Date.Games[i].Profile
+		if profile.HomeTeamID == teamID || profile.AwayTeamID == teamID {
+			games = append(games, game)
+		}
+	}
+	return games
+}
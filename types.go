@@ -1,5 +1,7 @@
 package main
 
+import "fmt"
+
 type GameInfo struct {
 	Context struct {
 		User struct {
@@ -13,11 +15,7 @@ type GameInfo struct {
 			Clazz interface{} `json:"clazz"`
 		} `json:"device"`
 	} `json:"context"`
-	Error struct {
-		Detail  interface{} `json:"detail"`
-		IsError string      `json:"isError"`
-		Message interface{} `json:"message"`
-	} `json:"error"`
+	Error   PayloadError `json:"error"`
 	Payload struct {
 		League struct {
 			ID   string `json:"id"`
@@ -83,11 +81,7 @@ type GamePlayerInfo struct {
 			Clazz interface{} `json:"clazz"`
 		} `json:"device"`
 	} `json:"context"`
-	Error struct {
-		Detail  interface{} `json:"detail"`
-		IsError string      `json:"isError"`
-		Message interface{} `json:"message"`
-	} `json:"error"`
+	Error   PayloadError `json:"error"`
 	Payload struct {
 		League struct {
 			ID   string `json:"id"`
@@ -160,53 +154,7 @@ type GamePlayerInfo struct {
 				Streak      string      `json:"streak"`
 				Wins        int         `json:"wins"`
 			} `json:"standing"`
-			Score struct {
-				Assists                int     `json:"assists"`
-				BiggestLead            int     `json:"biggestLead"`
-				Blocks                 int     `json:"blocks"`
-				BlocksAgainst          int     `json:"blocksAgainst"`
-				DefRebs                int     `json:"defRebs"`
-				Disqualifications      int     `json:"disqualifications"`
-				Ejections              int     `json:"ejections"`
-				FastBreakPoints        int     `json:"fastBreakPoints"`
-				Fga                    int     `json:"fga"`
-				Fgm                    int     `json:"fgm"`
-				Fgpct                  float64 `json:"fgpct"`
-				FlagrantFouls          int     `json:"flagrantFouls"`
-				Fouls                  int     `json:"fouls"`
-				Fta                    int     `json:"fta"`
-				Ftm                    int     `json:"ftm"`
-				Ftpct                  float64 `json:"ftpct"`
-				FullTimeoutsRemaining  int     `json:"fullTimeoutsRemaining"`
-				Mins                   int     `json:"mins"`
-				OffRebs                int     `json:"offRebs"`
-				Ot10Score              int     `json:"ot10Score"`
-				Ot1Score               int     `json:"ot1Score"`
-				Ot2Score               int     `json:"ot2Score"`
-				Ot3Score               int     `json:"ot3Score"`
-				Ot4Score               int     `json:"ot4Score"`
-				Ot5Score               int     `json:"ot5Score"`
-				Ot6Score               int     `json:"ot6Score"`
-				Ot7Score               int     `json:"ot7Score"`
-				Ot8Score               int     `json:"ot8Score"`
-				Ot9Score               int     `json:"ot9Score"`
-				PointsInPaint          int     `json:"pointsInPaint"`
-				PointsOffTurnovers     int     `json:"pointsOffTurnovers"`
-				Q1Score                int     `json:"q1Score"`
-				Q2Score                int     `json:"q2Score"`
-				Q3Score                int     `json:"q3Score"`
-				Q4Score                int     `json:"q4Score"`
-				Rebs                   int     `json:"rebs"`
-				Score                  int     `json:"score"`
-				Seconds                int     `json:"seconds"`
-				ShortTimeoutsRemaining int     `json:"shortTimeoutsRemaining"`
-				Steals                 int     `json:"steals"`
-				TechnicalFouls         int     `json:"technicalFouls"`
-				Tpa                    int     `json:"tpa"`
-				Tpm                    int     `json:"tpm"`
-				Tppct                  float64 `json:"tppct"`
-				Turnovers              int     `json:"turnovers"`
-			} `json:"score"`
+			Score   TeamScore `json:"score"`
 			Matchup struct {
 				ConfRank   string      `json:"confRank"`
 				DivRank    string      `json:"divRank"`
@@ -214,289 +162,13 @@ type GamePlayerInfo struct {
 				SeriesText interface{} `json:"seriesText"`
 				Wins       string      `json:"wins"`
 			} `json:"matchup"`
-			GamePlayers     []GamePlayers `json:"gamePlayers"`
-			PointGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"pointGameLeader"`
-			AssistGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"assistGameLeader"`
-			ReboundGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"reboundGameLeader"`
-			PointSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"pointSeasonLeader"`
-			AssistSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"assistSeasonLeader"`
-			ReboundSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"reboundSeasonLeader"`
+			GamePlayers         []GamePlayers `json:"gamePlayers"`
+			PointGameLeader     GameLeader    `json:"pointGameLeader"`
+			AssistGameLeader    GameLeader    `json:"assistGameLeader"`
+			ReboundGameLeader   GameLeader    `json:"reboundGameLeader"`
+			PointSeasonLeader   SeasonLeader  `json:"pointSeasonLeader"`
+			AssistSeasonLeader  SeasonLeader  `json:"assistSeasonLeader"`
+			ReboundSeasonLeader SeasonLeader  `json:"reboundSeasonLeader"`
 		} `json:"homeTeam"`
 		AwayTeam struct {
 			Profile struct {
@@ -525,53 +197,7 @@ type GamePlayerInfo struct {
 				Streak      string      `json:"streak"`
 				Wins        int         `json:"wins"`
 			} `json:"standing"`
-			Score struct {
-				Assists                int     `json:"assists"`
-				BiggestLead            int     `json:"biggestLead"`
-				Blocks                 int     `json:"blocks"`
-				BlocksAgainst          int     `json:"blocksAgainst"`
-				DefRebs                int     `json:"defRebs"`
-				Disqualifications      int     `json:"disqualifications"`
-				Ejections              int     `json:"ejections"`
-				FastBreakPoints        int     `json:"fastBreakPoints"`
-				Fga                    int     `json:"fga"`
-				Fgm                    int     `json:"fgm"`
-				Fgpct                  float64 `json:"fgpct"`
-				FlagrantFouls          int     `json:"flagrantFouls"`
-				Fouls                  int     `json:"fouls"`
-				Fta                    int     `json:"fta"`
-				Ftm                    int     `json:"ftm"`
-				Ftpct                  float64 `json:"ftpct"`
-				FullTimeoutsRemaining  int     `json:"fullTimeoutsRemaining"`
-				Mins                   int     `json:"mins"`
-				OffRebs                int     `json:"offRebs"`
-				Ot10Score              int     `json:"ot10Score"`
-				Ot1Score               int     `json:"ot1Score"`
-				Ot2Score               int     `json:"ot2Score"`
-				Ot3Score               int     `json:"ot3Score"`
-				Ot4Score               int     `json:"ot4Score"`
-				Ot5Score               int     `json:"ot5Score"`
-				Ot6Score               int     `json:"ot6Score"`
-				Ot7Score               int     `json:"ot7Score"`
-				Ot8Score               int     `json:"ot8Score"`
-				Ot9Score               int     `json:"ot9Score"`
-				PointsInPaint          int     `json:"pointsInPaint"`
-				PointsOffTurnovers     int     `json:"pointsOffTurnovers"`
-				Q1Score                int     `json:"q1Score"`
-				Q2Score                int     `json:"q2Score"`
-				Q3Score                int     `json:"q3Score"`
-				Q4Score                int     `json:"q4Score"`
-				Rebs                   int     `json:"rebs"`
-				Score                  int     `json:"score"`
-				Seconds                int     `json:"seconds"`
-				ShortTimeoutsRemaining int     `json:"shortTimeoutsRemaining"`
-				Steals                 int     `json:"steals"`
-				TechnicalFouls         int     `json:"technicalFouls"`
-				Tpa                    int     `json:"tpa"`
-				Tpm                    int     `json:"tpm"`
-				Tppct                  float64 `json:"tppct"`
-				Turnovers              int     `json:"turnovers"`
-			} `json:"score"`
+			Score   TeamScore `json:"score"`
 			Matchup struct {
 				ConfRank   string      `json:"confRank"`
 				DivRank    string      `json:"divRank"`
@@ -579,289 +205,13 @@ type GamePlayerInfo struct {
 				SeriesText interface{} `json:"seriesText"`
 				Wins       string      `json:"wins"`
 			} `json:"matchup"`
-			GamePlayers     []GamePlayers `json:"gamePlayers"`
-			PointGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"pointGameLeader"`
-			AssistGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"assistGameLeader"`
-			ReboundGameLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatTotal struct {
-					Assists   int     `json:"assists"`
-					Blocks    int     `json:"blocks"`
-					DefRebs   int     `json:"defRebs"`
-					Fga       int     `json:"fga"`
-					Fgm       int     `json:"fgm"`
-					Fgpct     float64 `json:"fgpct"`
-					Fouls     int     `json:"fouls"`
-					Fta       int     `json:"fta"`
-					Ftm       int     `json:"ftm"`
-					Ftpct     float64 `json:"ftpct"`
-					Mins      int     `json:"mins"`
-					OffRebs   int     `json:"offRebs"`
-					Points    int     `json:"points"`
-					Rebs      int     `json:"rebs"`
-					Secs      int     `json:"secs"`
-					Steals    int     `json:"steals"`
-					Tpa       int     `json:"tpa"`
-					Tpm       int     `json:"tpm"`
-					Tppct     float64 `json:"tppct"`
-					Turnovers int     `json:"turnovers"`
-				} `json:"statTotal"`
-			} `json:"reboundGameLeader"`
-			PointSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"pointSeasonLeader"`
-			AssistSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"assistSeasonLeader"`
-			ReboundSeasonLeader struct {
-				Profile struct {
-					Code               string `json:"code"`
-					Country            string `json:"country"`
-					DisplayAffiliation string `json:"displayAffiliation"`
-					DisplayName        string `json:"displayName"`
-					DisplayNameEn      string `json:"displayNameEn"`
-					Dob                string `json:"dob"`
-					DraftYear          string `json:"draftYear"`
-					Experience         string `json:"experience"`
-					FirstInitial       string `json:"firstInitial"`
-					FirstName          string `json:"firstName"`
-					FirstNameEn        string `json:"firstNameEn"`
-					Height             string `json:"height"`
-					JerseyNo           string `json:"jerseyNo"`
-					LastName           string `json:"lastName"`
-					LastNameEn         string `json:"lastNameEn"`
-					LeagueID           string `json:"leagueId"`
-					PlayerID           string `json:"playerId"`
-					Position           string `json:"position"`
-					SchoolType         string `json:"schoolType"`
-					Weight             string `json:"weight"`
-				} `json:"profile"`
-				StatAverage struct {
-					AssistsPg    float64 `json:"assistsPg"`
-					BlocksPg     float64 `json:"blocksPg"`
-					DefRebsPg    float64 `json:"defRebsPg"`
-					Efficiency   float64 `json:"efficiency"`
-					FgaPg        float64 `json:"fgaPg"`
-					FgmPg        float64 `json:"fgmPg"`
-					Fgpct        float64 `json:"fgpct"`
-					FoulsPg      float64 `json:"foulsPg"`
-					FtaPg        float64 `json:"ftaPg"`
-					FtmPg        float64 `json:"ftmPg"`
-					Ftpct        float64 `json:"ftpct"`
-					Games        int     `json:"games"`
-					GamesStarted int     `json:"gamesStarted"`
-					MinsPg       float64 `json:"minsPg"`
-					OffRebsPg    float64 `json:"offRebsPg"`
-					PointsPg     float64 `json:"pointsPg"`
-					RebsPg       float64 `json:"rebsPg"`
-					StealsPg     float64 `json:"stealsPg"`
-					TpaPg        float64 `json:"tpaPg"`
-					TpmPg        float64 `json:"tpmPg"`
-					Tppct        float64 `json:"tppct"`
-					TurnoversPg  float64 `json:"turnoversPg"`
-				} `json:"statAverage"`
-			} `json:"reboundSeasonLeader"`
+			GamePlayers         []GamePlayers `json:"gamePlayers"`
+			PointGameLeader     GameLeader    `json:"pointGameLeader"`
+			AssistGameLeader    GameLeader    `json:"assistGameLeader"`
+			ReboundGameLeader   GameLeader    `json:"reboundGameLeader"`
+			PointSeasonLeader   SeasonLeader  `json:"pointSeasonLeader"`
+			AssistSeasonLeader  SeasonLeader  `json:"assistSeasonLeader"`
+			ReboundSeasonLeader SeasonLeader  `json:"reboundSeasonLeader"`
 		} `json:"awayTeam"`
 	} `json:"payload"`
 	Timestamp string `json:"timestamp"`
@@ -880,11 +230,7 @@ type ConferenceStanding struct {
 			Clazz interface{} `json:"clazz"`
 		} `json:"device"`
 	} `json:"context"`
-	Error struct {
-		Detail  interface{} `json:"detail"`
-		IsError string      `json:"isError"`
-		Message interface{} `json:"message"`
-	} `json:"error"`
+	Error   PayloadError `json:"error"`
 	Payload struct {
 		League struct {
 			ID   string `json:"id"`
@@ -905,94 +251,120 @@ type ConferenceStanding struct {
 			YearDisplay             string `json:"yearDisplay"`
 		} `json:"season"`
 		StandingGroups []struct {
-			Teams []struct {
-				Profile struct {
-					Abbr              string `json:"abbr"`
-					City              string `json:"city"`
-					CityEn            string `json:"cityEn"`
-					Code              string `json:"code"`
-					Conference        string `json:"conference"`
-					DisplayAbbr       string `json:"displayAbbr"`
-					DisplayConference string `json:"displayConference"`
-					Division          string `json:"division"`
-					ID                string `json:"id"`
-					IsAllStarTeam     bool   `json:"isAllStarTeam"`
-					IsLeagueTeam      bool   `json:"isLeagueTeam"`
-					LeagueID          string `json:"leagueId"`
-					Name              string `json:"name"`
-					NameEn            string `json:"nameEn"`
-				} `json:"profile"`
-				Standings struct {
-					AheadAtHalfLoss     string  `json:"aheadAtHalfLoss"`
-					AheadAtHalfWin      string  `json:"aheadAtHalfWin"`
-					AheadAtThirdLoss    string  `json:"aheadAtThirdLoss"`
-					AheadAtThirdWin     string  `json:"aheadAtThirdWin"`
-					BehindAtHalfLoss    string  `json:"behindAtHalfLoss"`
-					BehindAtHalfWin     string  `json:"behindAtHalfWin"`
-					BehindAtThirdLoss   string  `json:"behindAtThirdLoss"`
-					BehindAtThirdWin    string  `json:"behindAtThirdWin"`
-					Clinched            string  `json:"clinched"`
-					ConfGamesBehind     float64 `json:"confGamesBehind"`
-					ConfLoss            int     `json:"confLoss"`
-					ConfRank            int     `json:"confRank"`
-					ConfWin             int     `json:"confWin"`
-					DivGameBehind       float64 `json:"divGameBehind"`
-					DivLoss             int     `json:"divLoss"`
-					DivRank             int     `json:"divRank"`
-					DivWin              int     `json:"divWin"`
-					FewerTurnoversLoss  string  `json:"fewerTurnoversLoss"`
-					FewerTurnoversWin   string  `json:"fewerTurnoversWin"`
-					HomeLoss            int     `json:"homeLoss"`
-					HomeStreak          string  `json:"homeStreak"`
-					HomeWin             int     `json:"homeWin"`
-					Last10              string  `json:"last10"`
-					Last10Home          string  `json:"last10Home"`
-					Last10Road          string  `json:"last10Road"`
-					LeadInFgpctloss     string  `json:"leadInFgpctloss"`
-					LeadInFgpctwin      string  `json:"leadInFgpctwin"`
-					LeadInRebLoss       string  `json:"leadInRebLoss"`
-					LeadInRebWin        string  `json:"leadInRebWin"`
-					LoseStreak          string  `json:"loseStreak"`
-					Losses              int     `json:"losses"`
-					OnHotStreak         string  `json:"onHotStreak"`
-					Oppover500Loss      string  `json:"oppover500Loss"`
-					Oppover500Win       string  `json:"oppover500Win"`
-					Oppscore100PlusLoss string  `json:"oppscore100PlusLoss"`
-					Oppscore100PlusWin  string  `json:"oppscore100PlusWin"`
-					Otloss              string  `json:"otloss"`
-					Otwin               string  `json:"otwin"`
-					PointsAgainst       float64 `json:"pointsAgainst"`
-					PointsDiff          float64 `json:"pointsDiff"`
-					PointsFor           float64 `json:"pointsFor"`
-					RoadLoss            int     `json:"roadLoss"`
-					RoadStreak          string  `json:"roadStreak"`
-					RoadWin             int     `json:"roadWin"`
-					Score100PlusLoss    string  `json:"score100PlusLoss"`
-					Score100PlusWin     string  `json:"score100PlusWin"`
-					Streak              string  `json:"streak"`
-					TenPtsOrMoreLoss    string  `json:"tenPtsOrMoreLoss"`
-					TenPtsOrMoreWin     string  `json:"tenPtsOrMoreWin"`
-					ThreePtsOrLessLoss  string  `json:"threePtsOrLessLoss"`
-					ThreePtsOrLessWin   string  `json:"threePtsOrLessWin"`
-					TiedAtHalfLoss      string  `json:"tiedAtHalfLoss"`
-					TiedAtHalfWin       string  `json:"tiedAtHalfWin"`
-					TiedAtThirdLoss     string  `json:"tiedAtThirdLoss"`
-					TiedAtThirdWin      string  `json:"tiedAtThirdWin"`
-					WinPct              float64 `json:"winPct"`
-					WinStreak           string  `json:"winStreak"`
-					Wins                int     `json:"wins"`
-				} `json:"standings"`
-			} `json:"teams"`
-			Conference        string      `json:"conference"`
-			DisplayConference string      `json:"displayConference"`
-			DisplayDivision   interface{} `json:"displayDivision"`
-			Division          interface{} `json:"division"`
+			Teams             []StandingTeam `json:"teams"`
+			Conference        string         `json:"conference"`
+			DisplayConference string         `json:"displayConference"`
+			DisplayDivision   interface{}    `json:"displayDivision"`
+			Division          interface{}    `json:"division"`
 		} `json:"standingGroups"`
 		Grouping string `json:"grouping"`
 	} `json:"payload"`
 	Timestamp string `json:"timestamp"`
 }
 
+// StandingTeam is a team of a conference standing
+type StandingTeam struct {
+	Profile struct {
+		Abbr              string `json:"abbr"`
+		City              string `json:"city"`
+		CityEn            string `json:"cityEn"`
+		Code              string `json:"code"`
+		Conference        string `json:"conference"`
+		DisplayAbbr       string `json:"displayAbbr"`
+		DisplayConference string `json:"displayConference"`
+		Division          string `json:"division"`
+		ID                string `json:"id"`
+		IsAllStarTeam     bool   `json:"isAllStarTeam"`
+		IsLeagueTeam      bool   `json:"isLeagueTeam"`
+		LeagueID          string `json:"leagueId"`
+		Name              string `json:"name"`
+		NameEn            string `json:"nameEn"`
+	} `json:"profile"`
+	Standings struct {
+		AheadAtHalfLoss     string  `json:"aheadAtHalfLoss"`
+		AheadAtHalfWin      string  `json:"aheadAtHalfWin"`
+		AheadAtThirdLoss    string  `json:"aheadAtThirdLoss"`
+		AheadAtThirdWin     string  `json:"aheadAtThirdWin"`
+		BehindAtHalfLoss    string  `json:"behindAtHalfLoss"`
+		BehindAtHalfWin     string  `json:"behindAtHalfWin"`
+		BehindAtThirdLoss   string  `json:"behindAtThirdLoss"`
+		BehindAtThirdWin    string  `json:"behindAtThirdWin"`
+		Clinched            string  `json:"clinched"`
+		ConfGamesBehind     float64 `json:"confGamesBehind"`
+		ConfLoss            int     `json:"confLoss"`
+		ConfRank            int     `json:"confRank"`
+		ConfWin             int     `json:"confWin"`
+		DivGameBehind       float64 `json:"divGameBehind"`
+		DivLoss             int     `json:"divLoss"`
+		DivRank             int     `json:"divRank"`
+		DivWin              int     `json:"divWin"`
+		FewerTurnoversLoss  string  `json:"fewerTurnoversLoss"`
+		FewerTurnoversWin   string  `json:"fewerTurnoversWin"`
+		HomeLoss            int     `json:"homeLoss"`
+		HomeStreak          string  `json:"homeStreak"`
+		HomeWin             int     `json:"homeWin"`
+		Last10              string  `json:"last10"`
+		Last10Home          string  `json:"last10Home"`
+		Last10Road          string  `json:"last10Road"`
+		LeadInFgpctloss     string  `json:"leadInFgpctloss"`
+		LeadInFgpctwin      string  `json:"leadInFgpctwin"`
+		LeadInRebLoss       string  `json:"leadInRebLoss"`
+		LeadInRebWin        string  `json:"leadInRebWin"`
+		LoseStreak          string  `json:"loseStreak"`
+		Losses              int     `json:"losses"`
+		OnHotStreak         string  `json:"onHotStreak"`
+		Oppover500Loss      string  `json:"oppover500Loss"`
+		Oppover500Win       string  `json:"oppover500Win"`
+		Oppscore100PlusLoss string  `json:"oppscore100PlusLoss"`
+		Oppscore100PlusWin  string  `json:"oppscore100PlusWin"`
+		Otloss              string  `json:"otloss"`
+		Otwin               string  `json:"otwin"`
+		PointsAgainst       float64 `json:"pointsAgainst"`
+		PointsDiff          float64 `json:"pointsDiff"`
+		PointsFor           float64 `json:"pointsFor"`
+		RoadLoss            int     `json:"roadLoss"`
+		RoadStreak          string  `json:"roadStreak"`
+		RoadWin             int     `json:"roadWin"`
+		Score100PlusLoss    string  `json:"score100PlusLoss"`
+		Score100PlusWin     string  `json:"score100PlusWin"`
+		Streak              string  `json:"streak"`
+		TenPtsOrMoreLoss    string  `json:"tenPtsOrMoreLoss"`
+		TenPtsOrMoreWin     string  `json:"tenPtsOrMoreWin"`
+		ThreePtsOrLessLoss  string  `json:"threePtsOrLessLoss"`
+		ThreePtsOrLessWin   string  `json:"threePtsOrLessWin"`
+		TiedAtHalfLoss      string  `json:"tiedAtHalfLoss"`
+		TiedAtHalfWin       string  `json:"tiedAtHalfWin"`
+		TiedAtThirdLoss     string  `json:"tiedAtThirdLoss"`
+		TiedAtThirdWin      string  `json:"tiedAtThirdWin"`
+		WinPct              float64 `json:"winPct"`
+		WinStreak           string  `json:"winStreak"`
+		Wins                int     `json:"wins"`
+	} `json:"standings"`
+}
+
+// PayloadError is the error block carried by every upstream payload
+type PayloadError struct {
+	Detail  interface{} `json:"detail"`
+	IsError string      `json:"isError"`
+	Message interface{} `json:"message"`
+}
+
+func (e *PayloadError) Err() error {
+	if e.IsError != "true" {
+		return nil
+	}
+	if e.Message == nil {
+		return fmt.Errorf("payload error")
+	}
+	return fmt.Errorf("payload error: %v", e.Message)
+}
+
+const (
+	GameStatusScheduled = "s1" // 未開賽
+	GameStatusLive      = "s2" // 比賽中
+	GameStatusFinal     = "s3" // 結束
+)
+
 type GameBoxscore struct {
 	Attendance            string `json:"attendance"`
 	AwayScore             int    `json:"awayScore"`
@@ -1032,191 +404,10 @@ type TeamInfo struct {
 		SeriesText interface{} `json:"seriesText"`
 		Wins       string      `json:"wins"`
 	} `json:"matchup"`
-	Score struct {
-		Assists                int     `json:"assists"`
-		BiggestLead            int     `json:"biggestLead"`
-		Blocks                 int     `json:"blocks"`
-		BlocksAgainst          int     `json:"blocksAgainst"`
-		DefRebs                int     `json:"defRebs"`
-		Disqualifications      int     `json:"disqualifications"`
-		Ejections              int     `json:"ejections"`
-		FastBreakPoints        int     `json:"fastBreakPoints"`
-		Fga                    int     `json:"fga"`
-		Fgm                    int     `json:"fgm"`
-		Fgpct                  float64 `json:"fgpct"`
-		FlagrantFouls          int     `json:"flagrantFouls"`
-		Fouls                  int     `json:"fouls"`
-		Fta                    int     `json:"fta"`
-		Ftm                    int     `json:"ftm"`
-		Ftpct                  float64 `json:"ftpct"`
-		FullTimeoutsRemaining  int     `json:"fullTimeoutsRemaining"`
-		Mins                   int     `json:"mins"`
-		OffRebs                int     `json:"offRebs"`
-		Ot10Score              int     `json:"ot10Score"`
-		Ot1Score               int     `json:"ot1Score"`
-		Ot2Score               int     `json:"ot2Score"`
-		Ot3Score               int     `json:"ot3Score"`
-		Ot4Score               int     `json:"ot4Score"`
-		Ot5Score               int     `json:"ot5Score"`
-		Ot6Score               int     `json:"ot6Score"`
-		Ot7Score               int     `json:"ot7Score"`
-		Ot8Score               int     `json:"ot8Score"`
-		Ot9Score               int     `json:"ot9Score"`
-		PointsInPaint          int     `json:"pointsInPaint"`
-		PointsOffTurnovers     int     `json:"pointsOffTurnovers"`
-		Q1Score                int     `json:"q1Score"`
-		Q2Score                int     `json:"q2Score"`
-		Q3Score                int     `json:"q3Score"`
-		Q4Score                int     `json:"q4Score"`
-		Rebs                   int     `json:"rebs"`
-		Score                  int     `json:"score"`
-		Seconds                int     `json:"seconds"`
-		ShortTimeoutsRemaining int     `json:"shortTimeoutsRemaining"`
-		Steals                 int     `json:"steals"`
-		TechnicalFouls         int     `json:"technicalFouls"`
-		Tpa                    int     `json:"tpa"`
-		Tpm                    int     `json:"tpm"`
-		Tppct                  float64 `json:"tppct"`
-		Turnovers              int     `json:"turnovers"`
-	} `json:"score"`
-	PointGameLeader struct {
-		Profile struct {
-			Code               string `json:"code"`
-			Country            string `json:"country"`
-			DisplayAffiliation string `json:"displayAffiliation"`
-			DisplayName        string `json:"displayName"`
-			DisplayNameEn      string `json:"displayNameEn"`
-			Dob                string `json:"dob"`
-			DraftYear          string `json:"draftYear"`
-			Experience         string `json:"experience"`
-			FirstInitial       string `json:"firstInitial"`
-			FirstName          string `json:"firstName"`
-			FirstNameEn        string `json:"firstNameEn"`
-			Height             string `json:"height"`
-			JerseyNo           string `json:"jerseyNo"`
-			LastName           string `json:"lastName"`
-			LastNameEn         string `json:"lastNameEn"`
-			LeagueID           string `json:"leagueId"`
-			PlayerID           string `json:"playerId"`
-			Position           string `json:"position"`
-			SchoolType         string `json:"schoolType"`
-			Weight             string `json:"weight"`
-		} `json:"profile"`
-		StatTotal struct {
-			Assists   int     `json:"assists"`
-			Blocks    int     `json:"blocks"`
-			DefRebs   int     `json:"defRebs"`
-			Fga       int     `json:"fga"`
-			Fgm       int     `json:"fgm"`
-			Fgpct     float64 `json:"fgpct"`
-			Fouls     int     `json:"fouls"`
-			Fta       int     `json:"fta"`
-			Ftm       int     `json:"ftm"`
-			Ftpct     float64 `json:"ftpct"`
-			Mins      int     `json:"mins"`
-			OffRebs   int     `json:"offRebs"`
-			Points    int     `json:"points"`
-			Rebs      int     `json:"rebs"`
-			Secs      int     `json:"secs"`
-			Steals    int     `json:"steals"`
-			Tpa       int     `json:"tpa"`
-			Tpm       int     `json:"tpm"`
-			Tppct     float64 `json:"tppct"`
-			Turnovers int     `json:"turnovers"`
-		} `json:"statTotal"`
-	} `json:"pointGameLeader"`
-	AssistGameLeader struct {
-		Profile struct {
-			Code               string `json:"code"`
-			Country            string `json:"country"`
-			DisplayAffiliation string `json:"displayAffiliation"`
-			DisplayName        string `json:"displayName"`
-			DisplayNameEn      string `json:"displayNameEn"`
-			Dob                string `json:"dob"`
-			DraftYear          string `json:"draftYear"`
-			Experience         string `json:"experience"`
-			FirstInitial       string `json:"firstInitial"`
-			FirstName          string `json:"firstName"`
-			FirstNameEn        string `json:"firstNameEn"`
-			Height             string `json:"height"`
-			JerseyNo           string `json:"jerseyNo"`
-			LastName           string `json:"lastName"`
-			LastNameEn         string `json:"lastNameEn"`
-			LeagueID           string `json:"leagueId"`
-			PlayerID           string `json:"playerId"`
-			Position           string `json:"position"`
-			SchoolType         string `json:"schoolType"`
-			Weight             string `json:"weight"`
-		} `json:"profile"`
-		StatTotal struct {
-			Assists   int     `json:"assists"`
-			Blocks    int     `json:"blocks"`
-			DefRebs   int     `json:"defRebs"`
-			Fga       int     `json:"fga"`
-			Fgm       int     `json:"fgm"`
-			Fgpct     float64 `json:"fgpct"`
-			Fouls     int     `json:"fouls"`
-			Fta       int     `json:"fta"`
-			Ftm       int     `json:"ftm"`
-			Ftpct     float64 `json:"ftpct"`
-			Mins      int     `json:"mins"`
-			OffRebs   int     `json:"offRebs"`
-			Points    int     `json:"points"`
-			Rebs      int     `json:"rebs"`
-			Secs      int     `json:"secs"`
-			Steals    int     `json:"steals"`
-			Tpa       int     `json:"tpa"`
-			Tpm       int     `json:"tpm"`
-			Tppct     float64 `json:"tppct"`
-			Turnovers int     `json:"turnovers"`
-		} `json:"statTotal"`
-	} `json:"assistGameLeader"`
-	ReboundGameLeader struct {
-		Profile struct {
-			Code               string `json:"code"`
-			Country            string `json:"country"`
-			DisplayAffiliation string `json:"displayAffiliation"`
-			DisplayName        string `json:"displayName"`
-			DisplayNameEn      string `json:"displayNameEn"`
-			Dob                string `json:"dob"`
-			DraftYear          string `json:"draftYear"`
-			Experience         string `json:"experience"`
-			FirstInitial       string `json:"firstInitial"`
-			FirstName          string `json:"firstName"`
-			FirstNameEn        string `json:"firstNameEn"`
-			Height             string `json:"height"`
-			JerseyNo           string `json:"jerseyNo"`
-			LastName           string `json:"lastName"`
-			LastNameEn         string `json:"lastNameEn"`
-			LeagueID           string `json:"leagueId"`
-			PlayerID           string `json:"playerId"`
-			Position           string `json:"position"`
-			SchoolType         string `json:"schoolType"`
-			Weight             string `json:"weight"`
-		} `json:"profile"`
-		StatTotal struct {
-			Assists   int     `json:"assists"`
-			Blocks    int     `json:"blocks"`
-			DefRebs   int     `json:"defRebs"`
-			Fga       int     `json:"fga"`
-			Fgm       int     `json:"fgm"`
-			Fgpct     float64 `json:"fgpct"`
-			Fouls     int     `json:"fouls"`
-			Fta       int     `json:"fta"`
-			Ftm       int     `json:"ftm"`
-			Ftpct     float64 `json:"ftpct"`
-			Mins      int     `json:"mins"`
-			OffRebs   int     `json:"offRebs"`
-			Points    int     `json:"points"`
-			Rebs      int     `json:"rebs"`
-			Secs      int     `json:"secs"`
-			Steals    int     `json:"steals"`
-			Tpa       int     `json:"tpa"`
-			Tpm       int     `json:"tpm"`
-			Tppct     float64 `json:"tppct"`
-			Turnovers int     `json:"turnovers"`
-		} `json:"statTotal"`
-	} `json:"reboundGameLeader"`
+	Score             TeamScore  `json:"score"`
+	PointGameLeader   GameLeader `json:"pointGameLeader"`
+	AssistGameLeader  GameLeader `json:"assistGameLeader"`
+	ReboundGameLeader GameLeader `json:"reboundGameLeader"`
 }
 
 type GamePlayers struct {
@@ -1287,11 +478,7 @@ type BracketInfo struct {
 			Clazz interface{} `json:"clazz"`
 		} `json:"device"`
 	} `json:"context"`
-	Error struct {
-		Detail  interface{} `json:"detail"`
-		IsError string      `json:"isError"`
-		Message interface{} `json:"message"`
-	} `json:"error"`
+	Error   PayloadError `json:"error"`
 	Payload struct {
 		League struct {
 			ID   string `json:"id"`
@@ -1315,69 +502,11 @@ type BracketInfo struct {
 		Groups   []struct {
 			Rounds []struct {
 				Series []struct {
-					HighSeedOrWest *struct {
-						Profile struct {
-							Abbr              string `json:"abbr"`
-							City              string `json:"city"`
-							CityEn            string `json:"cityEn"`
-							Code              string `json:"code"`
-							Conference        string `json:"conference"`
-							DisplayAbbr       string `json:"displayAbbr"`
-							DisplayConference string `json:"displayConference"`
-							Division          string `json:"division"`
-							ID                string `json:"id"`
-							IsAllStarTeam     bool   `json:"isAllStarTeam"`
-							IsLeagueTeam      bool   `json:"isLeagueTeam"`
-							LeagueID          string `json:"leagueId"`
-							Name              string `json:"name"`
-							NameEn            string `json:"nameEn"`
-						} `json:"profile"`
-						Standing struct {
-							Clinched    string `json:"clinched"`
-							ConfRank    int    `json:"confRank"`
-							DivRank     int    `json:"divRank"`
-							Last10      string `json:"last10"`
-							Losses      int    `json:"losses"`
-							OnHotStreak string `json:"onHotStreak"`
-							Streak      string `json:"streak"`
-							Wins        int    `json:"wins"`
-						} `json:"standing"`
-						IsWinner  bool `json:"isWinner"`
-						IsWinning bool `json:"isWinning"`
-					} `json:"highSeedOrWest"`
-					LowSeedOrEast *struct {
-						Profile struct {
-							Abbr              string `json:"abbr"`
-							City              string `json:"city"`
-							CityEn            string `json:"cityEn"`
-							Code              string `json:"code"`
-							Conference        string `json:"conference"`
-							DisplayAbbr       string `json:"displayAbbr"`
-							DisplayConference string `json:"displayConference"`
-							Division          string `json:"division"`
-							ID                string `json:"id"`
-							IsAllStarTeam     bool   `json:"isAllStarTeam"`
-							IsLeagueTeam      bool   `json:"isLeagueTeam"`
-							LeagueID          string `json:"leagueId"`
-							Name              string `json:"name"`
-							NameEn            string `json:"nameEn"`
-						} `json:"profile"`
-						Standing struct {
-							Clinched    string `json:"clinched"`
-							ConfRank    int    `json:"confRank"`
-							DivRank     int    `json:"divRank"`
-							Last10      string `json:"last10"`
-							Losses      int    `json:"losses"`
-							OnHotStreak string `json:"onHotStreak"`
-							Streak      string `json:"streak"`
-							Wins        int    `json:"wins"`
-						} `json:"standing"`
-						IsWinner  bool `json:"isWinner"`
-						IsWinning bool `json:"isWinning"`
-					} `json:"lowSeedOrEast"`
-					RecentHighlight string `json:"recentHighlight"`
-					SeriesNo        string `json:"seriesNo"`
-					SeriesText      string `json:"seriesText"`
+					HighSeedOrWest  *BracketTeam `json:"highSeedOrWest"`
+					LowSeedOrEast   *BracketTeam `json:"lowSeedOrEast"`
+					RecentHighlight string       `json:"recentHighlight"`
+					SeriesNo        string       `json:"seriesNo"`
+					SeriesText      string       `json:"seriesText"`
 				} `json:"series"`
 				DisplayRoundName string `json:"displayRoundName"`
 				RoundName        string `json:"roundName"`
@@ -1390,3 +519,182 @@ type BracketInfo struct {
 	} `json:"payload"`
 	Timestamp string `json:"timestamp"`
 }
+
+// BracketTeam is a team of a playoff series
+type BracketTeam struct {
+	Profile struct {
+		Abbr              string `json:"abbr"`
+		City              string `json:"city"`
+		CityEn            string `json:"cityEn"`
+		Code              string `json:"code"`
+		Conference        string `json:"conference"`
+		DisplayAbbr       string `json:"displayAbbr"`
+		DisplayConference string `json:"displayConference"`
+		Division          string `json:"division"`
+		ID                string `json:"id"`
+		IsAllStarTeam     bool   `json:"isAllStarTeam"`
+		IsLeagueTeam      bool   `json:"isLeagueTeam"`
+		LeagueID          string `json:"leagueId"`
+		Name              string `json:"name"`
+		NameEn            string `json:"nameEn"`
+	} `json:"profile"`
+	Standing struct {
+		Clinched    string `json:"clinched"`
+		ConfRank    int    `json:"confRank"`
+		DivRank     int    `json:"divRank"`
+		Last10      string `json:"last10"`
+		Losses      int    `json:"losses"`
+		OnHotStreak string `json:"onHotStreak"`
+		Streak      string `json:"streak"`
+		Wins        int    `json:"wins"`
+	} `json:"standing"`
+	IsWinner  bool `json:"isWinner"`
+	IsWinning bool `json:"isWinning"`
+}
+
+// TeamScore is the team totals of a game
+type TeamScore struct {
+	Assists                int     `json:"assists"`
+	BiggestLead            int     `json:"biggestLead"`
+	Blocks                 int     `json:"blocks"`
+	BlocksAgainst          int     `json:"blocksAgainst"`
+	DefRebs                int     `json:"defRebs"`
+	Disqualifications      int     `json:"disqualifications"`
+	Ejections              int     `json:"ejections"`
+	FastBreakPoints        int     `json:"fastBreakPoints"`
+	Fga                    int     `json:"fga"`
+	Fgm                    int     `json:"fgm"`
+	Fgpct                  float64 `json:"fgpct"`
+	FlagrantFouls          int     `json:"flagrantFouls"`
+	Fouls                  int     `json:"fouls"`
+	Fta                    int     `json:"fta"`
+	Ftm                    int     `json:"ftm"`
+	Ftpct                  float64 `json:"ftpct"`
+	FullTimeoutsRemaining  int     `json:"fullTimeoutsRemaining"`
+	Mins                   int     `json:"mins"`
+	OffRebs                int     `json:"offRebs"`
+	Ot10Score              int     `json:"ot10Score"`
+	Ot1Score               int     `json:"ot1Score"`
+	Ot2Score               int     `json:"ot2Score"`
+	Ot3Score               int     `json:"ot3Score"`
+	Ot4Score               int     `json:"ot4Score"`
+	Ot5Score               int     `json:"ot5Score"`
+	Ot6Score               int     `json:"ot6Score"`
+	Ot7Score               int     `json:"ot7Score"`
+	Ot8Score               int     `json:"ot8Score"`
+	Ot9Score               int     `json:"ot9Score"`
+	PointsInPaint          int     `json:"pointsInPaint"`
+	PointsOffTurnovers     int     `json:"pointsOffTurnovers"`
+	Q1Score                int     `json:"q1Score"`
+	Q2Score                int     `json:"q2Score"`
+	Q3Score                int     `json:"q3Score"`
+	Q4Score                int     `json:"q4Score"`
+	Rebs                   int     `json:"rebs"`
+	Score                  int     `json:"score"`
+	Seconds                int     `json:"seconds"`
+	ShortTimeoutsRemaining int     `json:"shortTimeoutsRemaining"`
+	Steals                 int     `json:"steals"`
+	TechnicalFouls         int     `json:"technicalFouls"`
+	Tpa                    int     `json:"tpa"`
+	Tpm                    int     `json:"tpm"`
+	Tppct                  float64 `json:"tppct"`
+	Turnovers              int     `json:"turnovers"`
+}
+
+// GameLeader is the leader of a team in a stat for the game
+type GameLeader struct {
+	Profile struct {
+		Code               string `json:"code"`
+		Country            string `json:"country"`
+		DisplayAffiliation string `json:"displayAffiliation"`
+		DisplayName        string `json:"displayName"`
+		DisplayNameEn      string `json:"displayNameEn"`
+		Dob                string `json:"dob"`
+		DraftYear          string `json:"draftYear"`
+		Experience         string `json:"experience"`
+		FirstInitial       string `json:"firstInitial"`
+		FirstName          string `json:"firstName"`
+		FirstNameEn        string `json:"firstNameEn"`
+		Height             string `json:"height"`
+		JerseyNo           string `json:"jerseyNo"`
+		LastName           string `json:"lastName"`
+		LastNameEn         string `json:"lastNameEn"`
+		LeagueID           string `json:"leagueId"`
+		PlayerID           string `json:"playerId"`
+		Position           string `json:"position"`
+		SchoolType         string `json:"schoolType"`
+		Weight             string `json:"weight"`
+	} `json:"profile"`
+	StatTotal struct {
+		Assists   int     `json:"assists"`
+		Blocks    int     `json:"blocks"`
+		DefRebs   int     `json:"defRebs"`
+		Fga       int     `json:"fga"`
+		Fgm       int     `json:"fgm"`
+		Fgpct     float64 `json:"fgpct"`
+		Fouls     int     `json:"fouls"`
+		Fta       int     `json:"fta"`
+		Ftm       int     `json:"ftm"`
+		Ftpct     float64 `json:"ftpct"`
+		Mins      int     `json:"mins"`
+		OffRebs   int     `json:"offRebs"`
+		Points    int     `json:"points"`
+		Rebs      int     `json:"rebs"`
+		Secs      int     `json:"secs"`
+		Steals    int     `json:"steals"`
+		Tpa       int     `json:"tpa"`
+		Tpm       int     `json:"tpm"`
+		Tppct     float64 `json:"tppct"`
+		Turnovers int     `json:"turnovers"`
+	} `json:"statTotal"`
+}
+
+// SeasonLeader is the leader of a team in a stat for the season
+type SeasonLeader struct {
+	Profile struct {
+		Code               string `json:"code"`
+		Country            string `json:"country"`
+		DisplayAffiliation string `json:"displayAffiliation"`
+		DisplayName        string `json:"displayName"`
+		DisplayNameEn      string `json:"displayNameEn"`
+		Dob                string `json:"dob"`
+		DraftYear          string `json:"draftYear"`
+		Experience         string `json:"experience"`
+		FirstInitial       string `json:"firstInitial"`
+		FirstName          string `json:"firstName"`
+		FirstNameEn        string `json:"firstNameEn"`
+		Height             string `json:"height"`
+		JerseyNo           string `json:"jerseyNo"`
+		LastName           string `json:"lastName"`
+		LastNameEn         string `json:"lastNameEn"`
+		LeagueID           string `json:"leagueId"`
+		PlayerID           string `json:"playerId"`
+		Position           string `json:"position"`
+		SchoolType         string `json:"schoolType"`
+		Weight             string `json:"weight"`
+	} `json:"profile"`
+	StatAverage struct {
+		AssistsPg    float64 `json:"assistsPg"`
+		BlocksPg     float64 `json:"blocksPg"`
+		DefRebsPg    float64 `json:"defRebsPg"`
+		Efficiency   float64 `json:"efficiency"`
+		FgaPg        float64 `json:"fgaPg"`
+		FgmPg        float64 `json:"fgmPg"`
+		Fgpct        float64 `json:"fgpct"`
+		FoulsPg      float64 `json:"foulsPg"`
+		FtaPg        float64 `json:"ftaPg"`
+		FtmPg        float64 `json:"ftmPg"`
+		Ftpct        float64 `json:"ftpct"`
+		Games        int     `json:"games"`
+		GamesStarted int     `json:"gamesStarted"`
+		MinsPg       float64 `json:"minsPg"`
+		OffRebsPg    float64 `json:"offRebsPg"`
+		PointsPg     float64 `json:"pointsPg"`
+		RebsPg       float64 `json:"rebsPg"`
+		StealsPg     float64 `json:"stealsPg"`
+		TpaPg        float64 `json:"tpaPg"`
+		TpmPg        float64 `json:"tpmPg"`
+		Tppct        float64 `json:"tppct"`
+		TurnoversPg  float64 `json:"turnoversPg"`
+	} `json:"statAverage"`
+}